@@ -0,0 +1,166 @@
+package committee
+
+import (
+	"sort"
+
+	"github.com/pactus-project/pactus/util/errors"
+)
+
+// Change holds the validators that joined or left the committee at a specific height.
+// Validators are identified by their numbers to keep the changes compact.
+type Change struct {
+	Height uint32
+	Joined []int32
+	Left   []int32
+}
+
+// ChangeLog records the changes of the committee members over heights.
+// Light clients can follow the changes to track the committee without
+// keeping the full state.
+//
+// The log is deterministic and can be reconstructed from the blocks,
+// since each block contains the committers of the previous height in its certificate.
+// Old changes can be removed by pruning the log.
+type ChangeLog struct {
+	initHeight     uint32
+	initCommitters []int32
+	lastHeight     uint32
+	lastCommitters []int32
+	changes        []Change
+}
+
+// NewChangeLog creates a new change log, starting from the given committers at the given height.
+func NewChangeLog(height uint32, committers []int32) *ChangeLog {
+	sorted := sortedCommitters(committers)
+
+	return &ChangeLog{
+		initHeight:     height,
+		initCommitters: sorted,
+		lastHeight:     height,
+		lastCommitters: sorted,
+		changes:        make([]Change, 0),
+	}
+}
+
+// Apply records the difference between the committers at the given height and
+// the last known committers. If there is no difference, nothing is recorded.
+func (l *ChangeLog) Apply(height uint32, committers []int32) error {
+	if height <= l.lastHeight {
+		return errors.Errorf(errors.ErrInvalidHeight,
+			"height should be greater than %v, got %v", l.lastHeight, height)
+	}
+
+	sorted := sortedCommitters(committers)
+	joined := difference(sorted, l.lastCommitters)
+	left := difference(l.lastCommitters, sorted)
+
+	l.lastHeight = height
+	l.lastCommitters = sorted
+
+	if len(joined) == 0 && len(left) == 0 {
+		return nil
+	}
+
+	l.changes = append(l.changes, Change{
+		Height: height,
+		Joined: joined,
+		Left:   left,
+	})
+
+	return nil
+}
+
+// Prune removes the changes before the given height, so the log starts from that height.
+// The committers at the given height become the initial committers of the log.
+func (l *ChangeLog) Prune(height uint32) {
+	if height <= l.initHeight {
+		return
+	}
+	if height > l.lastHeight {
+		height = l.lastHeight
+	}
+
+	committers, _ := l.Committers(height)
+	i := sort.Search(len(l.changes), func(i int) bool {
+		return l.changes[i].Height > height
+	})
+
+	changes := make([]Change, len(l.changes)-i)
+	copy(changes, l.changes[i:])
+
+	l.initHeight = height
+	l.initCommitters = committers
+	l.changes = changes
+}
+
+// Changes returns all the changes recorded from the given height onward.
+func (l *ChangeLog) Changes(fromHeight uint32) []Change {
+	i := sort.Search(len(l.changes), func(i int) bool {
+		return l.changes[i].Height >= fromHeight
+	})
+
+	changes := make([]Change, len(l.changes)-i)
+	copy(changes, l.changes[i:])
+
+	return changes
+}
+
+// Committers reconstructs the committers at the given height by applying
+// the recorded changes. Committers are sorted by their numbers.
+func (l *ChangeLog) Committers(height uint32) ([]int32, error) {
+	if height < l.initHeight || height > l.lastHeight {
+		return nil, errors.Errorf(errors.ErrInvalidHeight,
+			"height is out of range [%v, %v]", l.initHeight, l.lastHeight)
+	}
+
+	members := make(map[int32]bool, len(l.initCommitters))
+	for _, num := range l.initCommitters {
+		members[num] = true
+	}
+
+	for _, c := range l.changes {
+		if c.Height > height {
+			break
+		}
+		for _, num := range c.Left {
+			delete(members, num)
+		}
+		for _, num := range c.Joined {
+			members[num] = true
+		}
+	}
+
+	committers := make([]int32, 0, len(members))
+	for num := range members {
+		committers = append(committers, num)
+	}
+
+	return sortedCommitters(committers), nil
+}
+
+func sortedCommitters(committers []int32) []int32 {
+	sorted := make([]int32, len(committers))
+	copy(sorted, committers)
+	sort.Slice(sorted, func(i, j int) bool {
+		return sorted[i] < sorted[j]
+	})
+
+	return sorted
+}
+
+// difference returns the numbers in a that are not in b.
+// Both slices should be sorted.
+func difference(a, b []int32) []int32 {
+	diff := make([]int32, 0)
+	j := 0
+	for _, num := range a {
+		for j < len(b) && b[j] < num {
+			j++
+		}
+		if j == len(b) || b[j] != num {
+			diff = append(diff, num)
+		}
+	}
+
+	return diff
+}
@@ -0,0 +1,172 @@
+package committee_test
+
+import (
+	"testing"
+
+	"github.com/pactus-project/pactus/committee"
+	"github.com/pactus-project/pactus/types/validator"
+	"github.com/pactus-project/pactus/util/errors"
+	"github.com/pactus-project/pactus/util/testsuite"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestChangeLog(t *testing.T) {
+	ts := testsuite.NewTestSuite(t)
+
+	vals := make([]*validator.Validator, 8)
+	for i := 0; i < len(vals); i++ {
+		pub, _ := ts.RandomBLSKeyPair()
+		vals[i] = validator.NewValidator(pub, int32(i))
+	}
+
+	cmt, err := committee.NewCommittee(vals[:4], 4, vals[0].Address())
+	require.NoError(t, err)
+
+	log := committee.NewChangeLog(1, cmt.Committers())
+
+	// Height 2: No change
+	cmt.Update(0, nil)
+	assert.NoError(t, log.Apply(2, cmt.Committers()))
+
+	// Height 3: Validator 4 joins, validator 0 leaves
+	vals[4].UpdateLastJoinedHeight(2)
+	cmt.Update(0, []*validator.Validator{vals[4]})
+	assert.NoError(t, log.Apply(3, cmt.Committers()))
+
+	// Height 4: Validators 5 and 6 join, validators 1 and 2 leave
+	vals[5].UpdateLastJoinedHeight(3)
+	vals[6].UpdateLastJoinedHeight(3)
+	cmt.Update(0, []*validator.Validator{vals[5], vals[6]})
+	assert.NoError(t, log.Apply(4, cmt.Committers()))
+
+	// Height 5: Validator 7 joins, validator 3 leaves
+	vals[7].UpdateLastJoinedHeight(4)
+	cmt.Update(0, []*validator.Validator{vals[7]})
+	assert.NoError(t, log.Apply(5, cmt.Committers()))
+
+	t.Run("Should record the changes", func(t *testing.T) {
+		expected := []committee.Change{
+			{Height: 3, Joined: []int32{4}, Left: []int32{0}},
+			{Height: 4, Joined: []int32{5, 6}, Left: []int32{1, 2}},
+			{Height: 5, Joined: []int32{7}, Left: []int32{3}},
+		}
+		assert.Equal(t, expected, log.Changes(0))
+		assert.Equal(t, expected[1:], log.Changes(4))
+		assert.Empty(t, log.Changes(6))
+	})
+
+	t.Run("Should reconstruct the committee", func(t *testing.T) {
+		expected := map[uint32][]int32{
+			1: {0, 1, 2, 3},
+			2: {0, 1, 2, 3},
+			3: {1, 2, 3, 4},
+			4: {3, 4, 5, 6},
+			5: {4, 5, 6, 7},
+		}
+		for height, committers := range expected {
+			reconstructed, err := log.Committers(height)
+			assert.NoError(t, err)
+			assert.Equal(t, committers, reconstructed, "height %v", height)
+		}
+
+		reconstructed, _ := log.Committers(5)
+		assert.ElementsMatch(t, cmt.Committers(), reconstructed)
+	})
+
+	t.Run("Out of range height", func(t *testing.T) {
+		_, err := log.Committers(0)
+		assert.Equal(t, errors.Code(err), errors.ErrInvalidHeight)
+
+		_, err = log.Committers(6)
+		assert.Equal(t, errors.Code(err), errors.ErrInvalidHeight)
+	})
+
+	t.Run("Applying an old height should fail", func(t *testing.T) {
+		err := log.Apply(5, cmt.Committers())
+		assert.Equal(t, errors.Code(err), errors.ErrInvalidHeight)
+	})
+}
+
+func TestChangeLogFromCertificates(t *testing.T) {
+	// The change log can be built from the committers of the certificates,
+	// since each block contains the certificate of the previous height.
+	committersAt := [][]int32{
+		{0, 1, 2, 3},
+		{3, 0, 1, 2},
+		{3, 4, 1, 2},
+		{3, 4, 1, 2},
+		{5, 3, 4, 2},
+	}
+
+	log := committee.NewChangeLog(1, committersAt[0])
+	for i := 1; i < len(committersAt); i++ {
+		assert.NoError(t, log.Apply(uint32(i+1), committersAt[i]))
+	}
+
+	assert.Equal(t, []committee.Change{
+		{Height: 3, Joined: []int32{4}, Left: []int32{0}},
+		{Height: 5, Joined: []int32{5}, Left: []int32{1}},
+	}, log.Changes(0))
+
+	for i, committers := range committersAt {
+		reconstructed, err := log.Committers(uint32(i + 1))
+		assert.NoError(t, err)
+		assert.ElementsMatch(t, committers, reconstructed)
+	}
+}
+
+func TestChangeLogPrune(t *testing.T) {
+	committersAt := [][]int32{
+		{0, 1, 2, 3},
+		{3, 0, 1, 2},
+		{3, 4, 1, 2},
+		{3, 4, 1, 2},
+		{5, 3, 4, 2},
+		{5, 6, 4, 2},
+	}
+
+	log := committee.NewChangeLog(1, committersAt[0])
+	for i := 1; i < len(committersAt); i++ {
+		assert.NoError(t, log.Apply(uint32(i+1), committersAt[i]))
+	}
+
+	t.Run("Pruning an old height does nothing", func(t *testing.T) {
+		log.Prune(1)
+		assert.Len(t, log.Changes(0), 3)
+	})
+
+	t.Run("Pruning the changes before height 4", func(t *testing.T) {
+		log.Prune(4)
+		assert.Equal(t, []committee.Change{
+			{Height: 5, Joined: []int32{5}, Left: []int32{1}},
+			{Height: 6, Joined: []int32{6}, Left: []int32{3}},
+		}, log.Changes(0))
+
+		_, err := log.Committers(3)
+		assert.Equal(t, errors.Code(err), errors.ErrInvalidHeight)
+
+		for i := 3; i < len(committersAt); i++ {
+			reconstructed, err := log.Committers(uint32(i + 1))
+			assert.NoError(t, err)
+			assert.ElementsMatch(t, committersAt[i], reconstructed)
+		}
+	})
+
+	t.Run("The pruned log is the same as a log started from that height", func(t *testing.T) {
+		log2 := committee.NewChangeLog(4, committersAt[3])
+		for i := 4; i < len(committersAt); i++ {
+			assert.NoError(t, log2.Apply(uint32(i+1), committersAt[i]))
+		}
+		assert.Equal(t, log2.Changes(0), log.Changes(0))
+	})
+
+	t.Run("Pruning after the last height keeps the last committers", func(t *testing.T) {
+		log.Prune(10)
+		assert.Empty(t, log.Changes(0))
+
+		reconstructed, err := log.Committers(6)
+		assert.NoError(t, err)
+		assert.ElementsMatch(t, committersAt[5], reconstructed)
+	})
+}
@@ -56,6 +56,17 @@ func (c *committee) TotalPower() int64 {
 	return p
 }
 
+// TotalEffectivePower returns the total power of the committee at the given height,
+// excluding the stake that is not activated yet.
+func (c *committee) TotalEffectivePower(height, activationInterval uint32) int64 {
+	p := int64(0)
+	c.iterate(func(v *validator.Validator) (stop bool) {
+		p += v.EffectivePower(height, activationInterval)
+		return false
+	})
+	return p
+}
+
 func (c *committee) Update(lastRound int16, joined []*validator.Validator) {
 	sort.SliceStable(joined, func(i, j int) bool {
 		return joined[i].Number() < joined[j].Number()
@@ -112,6 +123,34 @@ func (c *committee) Update(lastRound int16, joined []*validator.Validator) {
 	}
 }
 
+// Remove removes the validator with the given address from the committee.
+// If the validator is the proposer, the next validator becomes the proposer.
+// The last validator in the committee can't be removed.
+// It returns true if the validator was removed.
+func (c *committee) Remove(addr crypto.Address) bool {
+	if c.validatorList.Len() <= 1 {
+		return false
+	}
+
+	for e := c.validatorList.Front(); e != nil; e = e.Next() {
+		if !e.Value.(*validator.Validator).Address().EqualsTo(addr) {
+			continue
+		}
+
+		if e == c.proposerPos {
+			c.proposerPos = c.proposerPos.Next()
+			if c.proposerPos == nil {
+				c.proposerPos = c.validatorList.Front()
+			}
+		}
+		c.validatorList.Remove(e)
+
+		return true
+	}
+
+	return false
+}
+
 // Validators retrieves a list of all validators in the committee.
 // A cloned instance of each validator is returned to avoid modification of the original objects.
 func (c *committee) Validators() []*validator.Validator {
@@ -386,3 +386,60 @@ func TestTotalPower(t *testing.T) {
 	assert.Equal(t, committee.TotalPower(), totalPower)
 	assert.Equal(t, committee.TotalPower(), totalStake+1)
 }
+
+func TestTotalEffectivePower(t *testing.T) {
+	ts := testsuite.NewTestSuite(t)
+
+	val1, _ := ts.GenerateTestValidator(0)
+	val2, _ := ts.GenerateTestValidator(1)
+	val3, _ := ts.GenerateTestValidator(2)
+
+	val1.UpdateLastBondingHeight(100)
+	val1.UpdateActivatingStake(1000)
+
+	committee, err := committee.NewCommittee([]*validator.Validator{val1, val2, val3}, 3, val1.Address())
+	assert.NoError(t, err)
+
+	assert.Equal(t, committee.TotalPower(), committee.TotalEffectivePower(150, 0))
+	assert.Equal(t, committee.TotalPower()-500, committee.TotalEffectivePower(150, 100))
+	assert.Equal(t, committee.TotalPower(), committee.TotalEffectivePower(200, 100))
+}
+
+func TestRemove(t *testing.T) {
+	ts := testsuite.NewTestSuite(t)
+
+	val1, _ := ts.GenerateTestValidator(1)
+	val2, _ := ts.GenerateTestValidator(2)
+	val3, _ := ts.GenerateTestValidator(3)
+	val4, _ := ts.GenerateTestValidator(4)
+
+	committee, _ := committee.NewCommittee([]*validator.Validator{val1, val2, val3, val4}, 4, val4.Address())
+
+	t.Run("Removing a non-existing validator", func(t *testing.T) {
+		assert.False(t, committee.Remove(ts.RandomAddress()))
+		assert.Equal(t, committee.Size(), 4)
+	})
+
+	t.Run("Removing a validator", func(t *testing.T) {
+		assert.True(t, committee.Remove(val2.Address()))
+		assert.False(t, committee.Contains(val2.Address()))
+		assert.Equal(t, committee.Committers(), []int32{1, 3, 4})
+		assert.Equal(t, committee.Proposer(0).Number(), int32(4))
+		assert.Equal(t, committee.Proposer(1).Number(), int32(1))
+		assert.Equal(t, committee.TotalPower(), val1.Power()+val3.Power()+val4.Power())
+	})
+
+	t.Run("Removing the proposer, the next one should be the proposer", func(t *testing.T) {
+		assert.True(t, committee.Remove(val4.Address()))
+		assert.Equal(t, committee.Committers(), []int32{1, 3})
+		assert.Equal(t, committee.Proposer(0).Number(), int32(1))
+		assert.Equal(t, committee.Proposer(1).Number(), int32(3))
+	})
+
+	t.Run("Removing the last validator is not allowed", func(t *testing.T) {
+		assert.True(t, committee.Remove(val1.Address()))
+		assert.False(t, committee.Remove(val3.Address()))
+		assert.Equal(t, committee.Committers(), []int32{3})
+		assert.Equal(t, committee.Proposer(0).Number(), int32(3))
+	})
+}
@@ -13,6 +13,7 @@ type Reader interface {
 	IsProposer(addr crypto.Address, round int16) bool
 	Size() int
 	TotalPower() int64
+	TotalEffectivePower(height, activationInterval uint32) int64
 	String() string
 }
 
@@ -20,4 +21,5 @@ type Committee interface {
 	Reader
 
 	Update(lastRound int16, joined []*validator.Validator)
+	Remove(addr crypto.Address) bool
 }
@@ -10,6 +10,7 @@ import (
 	"github.com/pactus-project/pactus/consensus"
 	"github.com/pactus-project/pactus/crypto"
 	"github.com/pactus-project/pactus/network"
+	"github.com/pactus-project/pactus/state"
 	"github.com/pactus-project/pactus/store"
 	"github.com/pactus-project/pactus/sync"
 	"github.com/pactus-project/pactus/txpool"
@@ -30,6 +31,7 @@ type Config struct {
 	Store     *store.Config     `toml:"store"`
 	Network   *network.Config   `toml:"network"`
 	Sync      *sync.Config      `toml:"sync"`
+	State     *state.Config     `toml:"state"`
 	TxPool    *txpool.Config    `toml:"tx_pool"`
 	Consensus *consensus.Config `toml:"consensus"`
 	Logger    *logger.Config    `toml:"logger"`
@@ -74,6 +76,7 @@ func DefaultConfig() *Config {
 		Store:     store.DefaultConfig(),
 		Network:   network.DefaultConfig(),
 		Sync:      sync.DefaultConfig(),
+		State:     state.DefaultConfig(),
 		TxPool:    txpool.DefaultConfig(),
 		Consensus: consensus.DefaultConfig(),
 		Logger:    logger.DefaultConfig(),
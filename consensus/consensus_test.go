@@ -84,16 +84,16 @@ func setup(t *testing.T) *testData {
 	// to prevent triggering timers before starting the tests to avoid double entries for new heights in some tests.
 	getTime := util.RoundNow(params.BlockTimeInSecond).Add(time.Duration(params.BlockTimeInSecond) * time.Second)
 	genDoc := genesis.MakeGenesis(getTime, accs, vals, params)
-	stX, err := state.LoadOrNewState(genDoc, []crypto.Signer{signers[tIndexX]},
+	stX, err := state.LoadOrNewState(state.DefaultConfig(), genDoc, []crypto.Signer{signers[tIndexX]},
 		store.MockingStore(ts), txPool, nil)
 	require.NoError(t, err)
-	stY, err := state.LoadOrNewState(genDoc, []crypto.Signer{signers[tIndexY]},
+	stY, err := state.LoadOrNewState(state.DefaultConfig(), genDoc, []crypto.Signer{signers[tIndexY]},
 		store.MockingStore(ts), txPool, nil)
 	require.NoError(t, err)
-	stB, err := state.LoadOrNewState(genDoc, []crypto.Signer{signers[tIndexB]},
+	stB, err := state.LoadOrNewState(state.DefaultConfig(), genDoc, []crypto.Signer{signers[tIndexB]},
 		store.MockingStore(ts), txPool, nil)
 	require.NoError(t, err)
-	stP, err := state.LoadOrNewState(genDoc, []crypto.Signer{signers[tIndexP]},
+	stP, err := state.LoadOrNewState(state.DefaultConfig(), genDoc, []crypto.Signer{signers[tIndexP]},
 		store.MockingStore(ts), txPool, nil)
 	require.NoError(t, err)
 
@@ -341,7 +341,7 @@ func TestNotInCommittee(t *testing.T) {
 	signer := crypto.NewSigner(prv)
 	store := store.MockingStore(td.TestSuite)
 
-	st, _ := state.LoadOrNewState(td.genDoc, []crypto.Signer{signer}, store, td.txPool, nil)
+	st, _ := state.LoadOrNewState(state.DefaultConfig(), td.genDoc, []crypto.Signer{signer}, store, td.txPool, nil)
 	Cons := NewConsensus(testConfig(), st, signer, signer.Address(), make(chan message.Message, 100),
 		newMediator())
 	cons := Cons.(*consensus)
@@ -2,6 +2,7 @@ package consensus
 
 import (
 	"github.com/pactus-project/pactus/types/proposal"
+	"github.com/pactus-project/pactus/types/validator"
 	"github.com/pactus-project/pactus/types/vote"
 	"github.com/pactus-project/pactus/util"
 )
@@ -37,8 +38,13 @@ func (s *newHeightState) decide() {
 		}
 	}
 
+	// The voting power excludes the stake that is not activated yet
 	vals := s.state.CommitteeValidators()
-	s.log.MoveToNewHeight(vals)
+	height := sateHeight + 1
+	activationInterval := s.state.Params().StakeActivationInterval
+	s.log.MoveToNewHeight(vals, func(val *validator.Validator) int64 {
+		return val.EffectivePower(height, activationInterval)
+	})
 
 	s.height = sateHeight + 1
 	s.round = 0
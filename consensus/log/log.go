@@ -11,6 +11,7 @@ import (
 
 type Log struct {
 	validators    []*validator.Validator
+	powerOf       voteset.PowerFunc
 	roundMessages []*Messages
 }
 
@@ -39,9 +40,9 @@ func (log *Log) HasVote(h hash.Hash) bool {
 func (log *Log) MustGetRoundMessages(round int16) *Messages {
 	for i := int16(len(log.roundMessages)); i <= round; i++ {
 		rv := &Messages{
-			prepareVotes:        voteset.NewVoteSet(i, vote.VoteTypePrepare, log.validators),
-			precommitVotes:      voteset.NewVoteSet(i, vote.VoteTypePrecommit, log.validators),
-			changeProposerVotes: voteset.NewVoteSet(i, vote.VoteTypeChangeProposer, log.validators),
+			prepareVotes:        voteset.NewVoteSet(i, vote.VoteTypePrepare, log.validators, log.powerOf),
+			precommitVotes:      voteset.NewVoteSet(i, vote.VoteTypePrecommit, log.validators, log.powerOf),
+			changeProposerVotes: voteset.NewVoteSet(i, vote.VoteTypeChangeProposer, log.validators, log.powerOf),
 		}
 
 		// expending votes slice
@@ -88,9 +89,10 @@ func (log *Log) SetRoundProposal(round int16, proposal *proposal.Proposal) {
 	m.proposal = proposal
 }
 
-func (log *Log) MoveToNewHeight(validators []*validator.Validator) {
+func (log *Log) MoveToNewHeight(validators []*validator.Validator, powerOf voteset.PowerFunc) {
 	log.roundMessages = make([]*Messages, 0)
 	log.validators = validators
+	log.powerOf = powerOf
 }
 
 func (log *Log) CanVote(addr crypto.Address) bool {
@@ -3,6 +3,7 @@ package log
 import (
 	"testing"
 
+	"github.com/pactus-project/pactus/types/validator"
 	"github.com/pactus-project/pactus/types/vote"
 	"github.com/pactus-project/pactus/util/testsuite"
 	"github.com/stretchr/testify/assert"
@@ -13,7 +14,7 @@ func TestMustGetRound(t *testing.T) {
 
 	committee, _ := ts.GenerateTestCommittee(4)
 	log := NewLog()
-	log.MoveToNewHeight(committee.Validators())
+	log.MoveToNewHeight(committee.Validators(), (*validator.Validator).Power)
 	log.MustGetRoundMessages(4)
 	assert.Nil(t, log.RoundMessages(5))
 	assert.NotNil(t, log.RoundMessages(1))
@@ -27,7 +28,7 @@ func TestAddVotes(t *testing.T) {
 	committee, signers := ts.GenerateTestCommittee(4)
 
 	log := NewLog()
-	log.MoveToNewHeight(committee.Validators())
+	log.MoveToNewHeight(committee.Validators(), (*validator.Validator).Power)
 	invalidVote, _ := ts.GenerateTestPrecommitVote(55, 5)
 	err := log.AddVote(invalidVote) // invalid height
 	assert.Error(t, err)
@@ -65,7 +66,7 @@ func TestSetRoundProposal(t *testing.T) {
 	committee, _ := ts.GenerateTestCommittee(4)
 	prop, _ := ts.GenerateTestProposal(101, 0)
 	log := NewLog()
-	log.MoveToNewHeight(committee.Validators())
+	log.MoveToNewHeight(committee.Validators(), (*validator.Validator).Power)
 	log.SetRoundProposal(4, prop)
 	assert.False(t, log.HasRoundProposal(0))
 	assert.True(t, log.HasRoundProposal(4))
@@ -80,7 +81,7 @@ func TestCanVote(t *testing.T) {
 
 	committee, signers := ts.GenerateTestCommittee(4)
 	log := NewLog()
-	log.MoveToNewHeight(committee.Validators())
+	log.MoveToNewHeight(committee.Validators(), (*validator.Validator).Power)
 
 	addr := ts.RandomAddress()
 	assert.True(t, log.CanVote(signers[0].Address()))
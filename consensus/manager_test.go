@@ -55,7 +55,7 @@ func TestManager(t *testing.T) {
 	broadcastCh := make(chan message.Message, 500)
 	txPool := txpool.MockingTxPool()
 
-	state, err := state.LoadOrNewState(genDoc, signers, store.MockingStore(ts), txPool, nil)
+	state, err := state.LoadOrNewState(state.DefaultConfig(), genDoc, signers, store.MockingStore(ts), txPool, nil)
 	require.NoError(t, err)
 
 	Mgr := NewManager(testConfig(), state, signers, rewardAddrs, broadcastCh)
@@ -12,26 +12,32 @@ import (
 	"github.com/pactus-project/pactus/util/errors"
 )
 
+// PowerFunc returns the voting power of the given validator.
+type PowerFunc func(val *validator.Validator) int64
+
 type VoteSet struct {
 	round      int16
 	voteType   vote.Type
 	validators []*validator.Validator
+	powerOf    PowerFunc
 	blockVotes map[hash.Hash]*blockVotes
 	allVotes   map[hash.Hash]*vote.Vote
 	totalPower int64
 	quorumHash *hash.Hash
 }
 
-func NewVoteSet(round int16, voteType vote.Type, validators []*validator.Validator) *VoteSet {
+func NewVoteSet(round int16, voteType vote.Type, validators []*validator.Validator,
+	powerOf PowerFunc) *VoteSet {
 	totalPower := int64(0)
 	for _, val := range validators {
-		totalPower += val.Power()
+		totalPower += powerOf(val)
 	}
 
 	return &VoteSet{
 		round:      round,
 		voteType:   voteType,
 		validators: validators,
+		powerOf:    powerOf,
 		totalPower: totalPower,
 		blockVotes: make(map[hash.Hash]*blockVotes),
 		allVotes:   make(map[hash.Hash]*vote.Vote),
@@ -115,7 +121,7 @@ func (vs *VoteSet) AddVote(v *vote.Vote) error {
 
 	blockVotes := vs.mustGetBlockVotes(v.BlockHash())
 	blockVotes.addVote(v)
-	blockVotes.power += val.Power()
+	blockVotes.power += vs.powerOf(val)
 	if vs.hasTwoThirdOfTotalPower(blockVotes.power) {
 		hash := v.BlockHash()
 		vs.quorumHash = &hash
@@ -35,7 +35,7 @@ func TestAddVote(t *testing.T) {
 
 	h1 := ts.RandomHash()
 	invSigner := ts.RandomSigner()
-	vs := NewVoteSet(5, vote.VoteTypePrecommit, committee.Validators())
+	vs := NewVoteSet(5, vote.VoteTypePrecommit, committee.Validators(), (*validator.Validator).Power)
 
 	v1 := vote.NewVote(vote.VoteTypePrecommit, 100, 5, h1, invSigner.Address())
 	v2 := vote.NewVote(vote.VoteTypePrecommit, 100, 5, h1, signers[0].Address())
@@ -71,7 +71,7 @@ func TestDuplicateVote(t *testing.T) {
 	h1 := ts.RandomHash()
 	h2 := ts.RandomHash()
 	h3 := ts.RandomHash()
-	vs := NewVoteSet(0, vote.VoteTypePrepare, committee.Validators())
+	vs := NewVoteSet(0, vote.VoteTypePrepare, committee.Validators(), (*validator.Validator).Power)
 
 	correctVote := vote.NewVote(vote.VoteTypePrepare, 1, 0, h1, signers[0].Address())
 	duplicatedVote1 := vote.NewVote(vote.VoteTypePrepare, 1, 0, h2, signers[0].Address())
@@ -110,7 +110,7 @@ func TestQuorum(t *testing.T) {
 
 	committee, signers := setupCommittee(t, ts, 1000, 1500, 2500, 2000)
 
-	vs := NewVoteSet(0, vote.VoteTypePrecommit, committee.Validators())
+	vs := NewVoteSet(0, vote.VoteTypePrecommit, committee.Validators(), (*validator.Validator).Power)
 	h1 := ts.RandomHash()
 	v1 := vote.NewVote(vote.VoteTypePrecommit, 1, 0, h1, signers[0].Address())
 	v2 := vote.NewVote(vote.VoteTypePrecommit, 1, 0, h1, signers[1].Address())
@@ -150,7 +150,7 @@ func TestPower(t *testing.T) {
 
 	committee, signers := setupCommittee(t, ts, 1000, 1500, 2500, 2000)
 
-	vs := NewVoteSet(0, vote.VoteTypePrecommit, committee.Validators())
+	vs := NewVoteSet(0, vote.VoteTypePrecommit, committee.Validators(), (*validator.Validator).Power)
 
 	h1 := ts.RandomHash()
 	h2 := ts.RandomHash()
@@ -189,7 +189,7 @@ func TestAllVotes(t *testing.T) {
 
 	committee, signers := setupCommittee(t, ts, 1000, 1500, 2500, 2000)
 
-	vs := NewVoteSet(0, vote.VoteTypeChangeProposer, committee.Validators())
+	vs := NewVoteSet(0, vote.VoteTypeChangeProposer, committee.Validators(), (*validator.Validator).Power)
 
 	v1 := vote.NewVote(vote.VoteTypeChangeProposer, 1, 0, hash.UndefHash, signers[0].Address())
 	v2 := vote.NewVote(vote.VoteTypeChangeProposer, 1, 0, hash.UndefHash, signers[1].Address())
@@ -218,7 +218,7 @@ func TestOneThirdPower(t *testing.T) {
 
 	committee, signers := setupCommittee(t, ts, 1000, 1000, 1500, 1500)
 
-	vs := NewVoteSet(0, vote.VoteTypeChangeProposer, committee.Validators())
+	vs := NewVoteSet(0, vote.VoteTypeChangeProposer, committee.Validators(), (*validator.Validator).Power)
 
 	v1 := vote.NewVote(vote.VoteTypeChangeProposer, 1, 0, hash.UndefHash, signers[0].Address())
 	v2 := vote.NewVote(vote.VoteTypeChangeProposer, 1, 0, hash.UndefHash, signers[1].Address())
@@ -232,3 +232,38 @@ func TestOneThirdPower(t *testing.T) {
 	assert.NoError(t, vs.AddVote(v2))
 	assert.True(t, vs.BlockHashHasOneThirdOfTotalPower(hash.UndefHash))
 }
+
+func TestCustomPower(t *testing.T) {
+	ts := testsuite.NewTestSuite(t)
+
+	committee, signers := setupCommittee(t, ts, 1000, 1000, 1000, 1000)
+
+	// The first validator has no effective power, so the other three validators
+	// hold the whole power of the committee.
+	powerOf := func(val *validator.Validator) int64 {
+		if val.Address() == signers[0].Address() {
+			return 0
+		}
+		return val.Power()
+	}
+	vs := NewVoteSet(0, vote.VoteTypePrecommit, committee.Validators(), powerOf)
+
+	h1 := ts.RandomHash()
+	v1 := vote.NewVote(vote.VoteTypePrecommit, 1, 0, h1, signers[0].Address())
+	v2 := vote.NewVote(vote.VoteTypePrecommit, 1, 0, h1, signers[1].Address())
+	v3 := vote.NewVote(vote.VoteTypePrecommit, 1, 0, h1, signers[2].Address())
+	v4 := vote.NewVote(vote.VoteTypePrecommit, 1, 0, h1, signers[3].Address())
+
+	signers[0].SignMsg(v1)
+	signers[1].SignMsg(v2)
+	signers[2].SignMsg(v3)
+	signers[3].SignMsg(v4)
+
+	assert.NoError(t, vs.AddVote(v1))
+	assert.NoError(t, vs.AddVote(v2))
+	assert.NoError(t, vs.AddVote(v3))
+	assert.Nil(t, vs.QuorumHash())
+
+	assert.NoError(t, vs.AddVote(v4))
+	assert.Equal(t, h1, *vs.QuorumHash())
+}
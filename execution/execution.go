@@ -16,28 +16,34 @@ type Executor interface {
 type Execution struct {
 	executors      map[payload.Type]Executor
 	accumulatedFee int64
-	strict         bool
+	policy         executor.Policy
 }
 
-func newExecution(strict bool) *Execution {
+func newExecution(policy executor.Policy) *Execution {
 	execs := make(map[payload.Type]Executor)
-	execs[payload.PayloadTypeTransfer] = executor.NewTransferExecutor(strict)
-	execs[payload.PayloadTypeBond] = executor.NewBondExecutor(strict)
-	execs[payload.PayloadTypeSortition] = executor.NewSortitionExecutor(strict)
-	execs[payload.PayloadTypeUnbond] = executor.NewUnbondExecutor(strict)
-	execs[payload.PayloadTypeWithdraw] = executor.NewWithdrawExecutor(strict)
+	execs[payload.PayloadTypeTransfer] = executor.NewTransferExecutor(policy)
+	execs[payload.PayloadTypeBond] = executor.NewBondExecutor(policy)
+	execs[payload.PayloadTypeSortition] = executor.NewSortitionExecutor(policy)
+	execs[payload.PayloadTypeUnbond] = executor.NewUnbondExecutor(policy)
+	execs[payload.PayloadTypeWithdraw] = executor.NewWithdrawExecutor(policy)
+	execs[payload.PayloadTypeUnjail] = executor.NewUnjailExecutor(policy)
 
 	return &Execution{
 		executors: execs,
-		strict:    strict,
+		policy:    policy,
 	}
 }
 func NewExecutor() *Execution {
-	return newExecution(true)
+	return newExecution(executor.StrictPolicy())
 }
 
 func NewChecker() *Execution {
-	return newExecution(false)
+	return newExecution(executor.NonStrictPolicy())
+}
+
+// Policy returns the policy that defines the checks of this execution.
+func (exe *Execution) Policy() executor.Policy {
+	return exe.policy
 }
 
 func (exe *Execution) Execute(trx *tx.Tx, sb sandbox.Sandbox) error {
@@ -90,7 +96,7 @@ func (exe *Execution) checkLockTime(trx *tx.Tx, sb sandbox.Sandbox) error {
 	}
 
 	if curHeight < lockTimeHeight {
-		if exe.strict {
+		if exe.policy.RejectUnfinalizedLockTime {
 			return errors.Errorf(errors.ErrInvalidTx, "unfinalized transaction")
 		}
 	}
@@ -117,11 +123,16 @@ func (exe *Execution) checkStamp(trx *tx.Tx, sb sandbox.Sandbox) error {
 }
 
 func (exe *Execution) checkFee(trx *tx.Tx, sb sandbox.Sandbox) error {
-	if trx.IsFreeTx() {
+	switch {
+	case trx.IsSystemTx():
 		if trx.Fee() != 0 {
-			return errors.Errorf(errors.ErrInvalidTx, "fee is wrong, expected: 0, got: %v", trx.Fee())
+			return errors.Errorf(errors.ErrInvalidFee, "system transaction should have no fee, got: %v", trx.Fee())
 		}
-	} else {
+	case trx.IsFreeTx():
+		if trx.Fee() != 0 {
+			return errors.Errorf(errors.ErrInvalidFee, "fee is wrong, expected: 0, got: %v", trx.Fee())
+		}
+	default:
 		fee := calculateFee(trx.Payload().Value(), sb)
 		if trx.Fee() != fee {
 			return errors.Errorf(errors.ErrInvalidFee, "fee is wrong, expected: %v, got: %v", fee, trx.Fee())
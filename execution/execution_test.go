@@ -250,3 +250,52 @@ func TestFee(t *testing.T) {
 			"test %v failed. invalid fee", i)
 	}
 }
+
+func TestSystemTxFee(t *testing.T) {
+	ts := testsuite.NewTestSuite(t)
+
+	exe := NewChecker()
+	sb := sandbox.MockingSandbox(ts)
+
+	subsidyTx := tx.NewSubsidyTx(ts.RandomStamp(), 1, ts.RandomAddress(), 1e9, "subsidy")
+	transferTx, _ := ts.GenerateTestTransferTx()
+	bondTx, _ := ts.GenerateTestBondTx()
+	unbondTx, _ := ts.GenerateTestUnbondTx()
+	withdrawTx, _ := ts.GenerateTestWithdrawTx()
+	sortitionTx, _ := ts.GenerateTestSortitionTx()
+	unjailTx, _ := ts.GenerateTestUnjailTx()
+
+	tests := []struct {
+		name            string
+		pld             payload.Payload
+		isSystem        bool
+		expectedErrCode int
+	}{
+		{"Subsidy", subsidyTx.Payload(), true, errors.ErrNone},
+		{"Transfer", transferTx.Payload(), false, errors.ErrInvalidFee},
+		{"Bond", bondTx.Payload(), false, errors.ErrInvalidFee},
+		{"Unbond", unbondTx.Payload(), false, errors.ErrNone},
+		{"Withdraw", withdrawTx.Payload(), false, errors.ErrInvalidFee},
+		{"Sortition", sortitionTx.Payload(), false, errors.ErrNone},
+		{"Unjail", unjailTx.Payload(), false, errors.ErrNone},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name+" transaction with zero fee", func(t *testing.T) {
+			trx := tx.NewTx(ts.RandomStamp(), 1, test.pld, 0, "zero fee")
+			assert.Equal(t, test.isSystem, trx.IsSystemTx())
+
+			err := exe.checkFee(trx, sb)
+			assert.Equal(t, test.expectedErrCode, errors.Code(err))
+		})
+	}
+
+	t.Run("System transaction with non-zero fee, Should returns error", func(t *testing.T) {
+		trx := tx.NewTransferTx(ts.RandomStamp(), 1, crypto.TreasuryAddress,
+			ts.RandomAddress(), 1e9, 1000, "system tx")
+		assert.True(t, trx.IsSystemTx())
+
+		err := exe.checkFee(trx, sb)
+		assert.Equal(t, errors.Code(err), errors.ErrInvalidFee)
+	})
+}
@@ -1,6 +1,7 @@
 package executor
 
 import (
+	"github.com/pactus-project/pactus/crypto"
 	"github.com/pactus-project/pactus/sandbox"
 	"github.com/pactus-project/pactus/types/tx"
 	"github.com/pactus-project/pactus/types/tx/payload"
@@ -9,11 +10,11 @@ import (
 
 type BondExecutor struct {
 	fee    int64
-	strict bool
+	policy Policy
 }
 
-func NewBondExecutor(strict bool) *BondExecutor {
-	return &BondExecutor{strict: strict}
+func NewBondExecutor(policy Policy) *BondExecutor {
+	return &BondExecutor{policy: policy}
 }
 
 func (e *BondExecutor) Execute(trx *tx.Tx, sb sandbox.Sandbox) error {
@@ -29,40 +30,40 @@ func (e *BondExecutor) Execute(trx *tx.Tx, sb sandbox.Sandbox) error {
 			"expected: %v, got: %v", senderAcc.Sequence()+1, trx.Sequence())
 	}
 	receiverVal := sb.Validator(pld.Receiver)
+	if err := validateBondPublicKey(pld, receiverVal != nil); err != nil {
+		return err
+	}
 	if receiverVal == nil {
-		if pld.PublicKey == nil {
-			return errors.Errorf(errors.ErrInvalidPublicKey,
-				"public key is not set")
+		// The cap is checked before creating the validator, otherwise the sandbox
+		// keeps the new validator even if the transaction is rejected.
+		// Unbonded validators are counted too, since they remain in the state.
+		maxTotal := sb.Params().MaxTotalValidators
+		if maxTotal > 0 && sb.TotalValidators() >= maxTotal {
+			return errors.Errorf(errors.ErrTooManyValidators,
+				"total number of validators can't be more than %v", maxTotal)
 		}
 		receiverVal = sb.MakeNewValidator(pld.PublicKey)
-	} else {
-		if pld.PublicKey != nil {
-			return errors.Errorf(errors.ErrInvalidPublicKey,
-				"public key set")
-		}
 	}
 	if receiverVal.UnbondingHeight() > 0 {
 		return errors.Errorf(errors.ErrInvalidHeight,
 			"validator has unbonded at height %v", receiverVal.UnbondingHeight())
 	}
-	if e.strict {
-		// In strict mode, bond transactions will be rejected if a validator is
-		// in the committee.
-		// In non-strict mode, we accept them and keep them inside the transaction pool
-		// to process them when the validator leaves the committee.
-		if sb.Committee().Contains(pld.Receiver) {
-			return errors.Errorf(errors.ErrInvalidTx,
-				"validator %v is in committee", pld.Receiver)
-		}
+	// In strict mode, bond transactions will be rejected if a validator is
+	// in the committee.
+	// In non-strict mode, we accept them and keep them inside the transaction pool
+	// to process them when the validator leaves the committee.
+	if e.policy.RejectBondInCommittee && sb.Committee().Contains(pld.Receiver) {
+		return errors.Errorf(errors.ErrInvalidTx,
+			"validator %v is in committee", pld.Receiver)
+	}
 
-		// In strict mode, bond transactions will be rejected if a validator is
-		// going to be in the committee for the next height.
-		// In non-strict mode, we accept it and keep it inside the transaction pool to
-		// process it when the validator leaves the committee.
-		if receiverVal.LastJoinedHeight() == sb.CurrentHeight() {
-			return errors.Errorf(errors.ErrInvalidTx,
-				"validator %v joins committee in the next height", pld.Receiver)
-		}
+	// In strict mode, bond transactions will be rejected if a validator is
+	// going to be in the committee for the next height.
+	// In non-strict mode, we accept it and keep it inside the transaction pool to
+	// process it when the validator leaves the committee.
+	if e.policy.RejectBondJoiningCommittee && receiverVal.LastJoinedHeight() == sb.CurrentHeight() {
+		return errors.Errorf(errors.ErrInvalidTx,
+			"validator %v joins committee in the next height", pld.Receiver)
 	}
 	if senderAcc.Balance() < pld.Stake+trx.Fee() {
 		return errors.Error(errors.ErrInsufficientFunds)
@@ -72,6 +73,13 @@ func (e *BondExecutor) Execute(trx *tx.Tx, sb sandbox.Sandbox) error {
 			"validator's stake can't be more than %v", sb.Params().MaximumStake)
 	}
 
+	// The bonded stake becomes effective gradually over the activation interval.
+	// The part of the previous bonded stake that is not activated yet, activates with the new stake.
+	if interval := sb.Params().StakeActivationInterval; interval > 0 {
+		inactiveStake := receiverVal.InactiveStake(sb.CurrentHeight(), interval)
+		receiverVal.UpdateActivatingStake(inactiveStake + pld.Stake)
+	}
+
 	senderAcc.IncSequence()
 	senderAcc.SubtractFromBalance(pld.Stake + trx.Fee())
 	receiverVal.AddToStake(pld.Stake)
@@ -89,3 +97,64 @@ func (e *BondExecutor) Execute(trx *tx.Tx, sb sandbox.Sandbox) error {
 func (e *BondExecutor) Fee() int64 {
 	return e.fee
 }
+
+// PublicKeyReason explains why the public key of a bond transaction is invalid.
+type PublicKeyReason int
+
+const (
+	// PublicKeyMissing means the public key is not set for a new validator.
+	PublicKeyMissing PublicKeyReason = 1
+	// PublicKeyUnexpected means the public key is set for an existing validator.
+	PublicKeyUnexpected PublicKeyReason = 2
+	// PublicKeyMismatched means the public key doesn't belong to the receiver address.
+	PublicKeyMismatched PublicKeyReason = 3
+)
+
+func (r PublicKeyReason) String() string {
+	switch r {
+	case PublicKeyMissing:
+		return "public key is not set for a new validator"
+	case PublicKeyUnexpected:
+		return "public key is set for an existing validator"
+	case PublicKeyMismatched:
+		return "public key doesn't match the receiver address"
+	}
+	return "unknown reason"
+}
+
+// PublicKeyError is returned when the public key of a bond transaction is invalid.
+// Its error code is ErrInvalidPublicKey.
+type PublicKeyError struct {
+	Reason   PublicKeyReason
+	Receiver crypto.Address
+}
+
+func (e *PublicKeyError) Error() string {
+	return errors.Errorf(errors.ErrInvalidPublicKey,
+		"%s, receiver: %s", e.Reason, e.Receiver.String()).Error()
+}
+
+func (e *PublicKeyError) Code() int {
+	return errors.ErrInvalidPublicKey
+}
+
+// validateBondPublicKey checks the public key of the bond transaction.
+// The public key is required to create a new validator, and it should not be
+// set when bonding to an existing validator. If set, it should belong to the receiver.
+func validateBondPublicKey(pld *payload.BondPayload, validatorExists bool) error {
+	if pld.PublicKey != nil && pld.PublicKey.VerifyAddress(pld.Receiver) != nil {
+		return &PublicKeyError{Reason: PublicKeyMismatched, Receiver: pld.Receiver}
+	}
+
+	if validatorExists {
+		if pld.PublicKey != nil {
+			return &PublicKeyError{Reason: PublicKeyUnexpected, Receiver: pld.Receiver}
+		}
+	} else {
+		if pld.PublicKey == nil {
+			return &PublicKeyError{Reason: PublicKeyMissing, Receiver: pld.Receiver}
+		}
+	}
+
+	return nil
+}
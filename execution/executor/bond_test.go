@@ -4,14 +4,17 @@ import (
 	"testing"
 
 	"github.com/pactus-project/pactus/crypto"
+	"github.com/pactus-project/pactus/crypto/bls"
 	"github.com/pactus-project/pactus/types/tx"
+	"github.com/pactus-project/pactus/types/tx/payload"
 	"github.com/pactus-project/pactus/util/errors"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestExecuteBondTx(t *testing.T) {
 	td := setup(t)
-	exe := NewBondExecutor(true)
+	exe := NewBondExecutor(StrictPolicy())
 
 	senderAddr, senderAcc := td.sandbox.TestStore.RandomTestAcc()
 	senderBalance := senderAcc.Balance()
@@ -110,8 +113,8 @@ func TestExecuteBondTx(t *testing.T) {
 func TestBondInsideCommittee(t *testing.T) {
 	td := setup(t)
 
-	exe1 := NewBondExecutor(true)
-	exe2 := NewBondExecutor(false)
+	exe1 := NewBondExecutor(StrictPolicy())
+	exe2 := NewBondExecutor(NonStrictPolicy())
 	senderAddr, senderAcc := td.sandbox.TestStore.RandomTestAcc()
 	senderBalance := senderAcc.Balance()
 	fee, amt := td.randomAmountAndFee(senderBalance)
@@ -130,8 +133,8 @@ func TestBondInsideCommittee(t *testing.T) {
 func TestBondJoiningCommittee(t *testing.T) {
 	td := setup(t)
 
-	exe1 := NewBondExecutor(true)
-	exe2 := NewBondExecutor(false)
+	exe1 := NewBondExecutor(StrictPolicy())
+	exe2 := NewBondExecutor(NonStrictPolicy())
 	senderAddr, senderAcc := td.sandbox.TestStore.RandomTestAcc()
 	senderBalance := senderAcc.Balance()
 	pub, _ := td.RandomBLSKeyPair()
@@ -153,7 +156,7 @@ func TestBondJoiningCommittee(t *testing.T) {
 func TestStakeExceeded(t *testing.T) {
 	td := setup(t)
 
-	exe := NewBondExecutor(true)
+	exe := NewBondExecutor(StrictPolicy())
 	amt := td.sandbox.TestParams.MaximumStake + 1
 	fee := int64(float64(amt) * td.sandbox.Params().FeeFraction)
 	senderAddr, senderAcc := td.sandbox.TestStore.RandomTestAcc()
@@ -166,3 +169,133 @@ func TestStakeExceeded(t *testing.T) {
 
 	assert.Error(t, exe.Execute(trx, td.sandbox))
 }
+
+func TestBondStakeActivation(t *testing.T) {
+	td := setup(t)
+	exe := NewBondExecutor(StrictPolicy())
+
+	interval := uint32(100)
+	td.sandbox.TestParams.StakeActivationInterval = interval
+
+	senderAddr, senderAcc := td.sandbox.TestStore.RandomTestAcc()
+	pub, _ := td.RandomBLSKeyPair()
+	receiverAddr := pub.Address()
+	amt1 := (senderAcc.Balance() / 400) * 100
+	amt2 := (senderAcc.Balance() / 400) * 100
+	fee := int64(1000)
+
+	height1 := td.sandbox.CurrentHeight()
+	trx := tx.NewBondTx(td.stamp500000, senderAcc.Sequence()+1, senderAddr,
+		receiverAddr, pub, amt1, fee, "first bond")
+	assert.NoError(t, exe.Execute(trx, td.sandbox))
+
+	val := td.sandbox.Validator(receiverAddr)
+	assert.Equal(t, val.Stake(), amt1, "stake should be recorded immediately")
+	assert.Equal(t, val.ActivatingStake(), amt1)
+	assert.Zero(t, val.EffectivePower(height1, interval))
+	assert.Equal(t, val.EffectivePower(height1+interval/4, interval), amt1/4)
+	assert.Equal(t, val.EffectivePower(height1+interval/2, interval), amt1/2)
+	assert.Equal(t, val.EffectivePower(height1+interval, interval), amt1)
+
+	// Bonding more stake in the middle of the activation
+	height2 := height1 + interval/2
+	td.sandbox.TestStore.AddTestBlock(height2 - 1)
+	trx = tx.NewBondTx(td.stamp500000, senderAcc.Sequence()+2, senderAddr,
+		receiverAddr, nil, amt2, fee, "second bond")
+	assert.NoError(t, exe.Execute(trx, td.sandbox))
+
+	val = td.sandbox.Validator(receiverAddr)
+	assert.Equal(t, val.Stake(), amt1+amt2, "stake should be recorded immediately")
+	assert.Equal(t, val.ActivatingStake(), amt1/2+amt2)
+	assert.Equal(t, val.EffectivePower(height2, interval), amt1/2,
+		"effective power should not change by bonding")
+	assert.Equal(t, val.EffectivePower(height2+interval, interval), amt1+amt2)
+	td.checkTotalCoin(t, fee*2)
+}
+
+// TestMaxTotalValidators checks the cap on the total number of validators.
+func TestMaxTotalValidators(t *testing.T) {
+	td := setup(t)
+	exe := NewBondExecutor(StrictPolicy())
+
+	senderAddr, senderAcc := td.sandbox.TestStore.RandomTestAcc()
+	senderBalance := senderAcc.Balance()
+	td.sandbox.TestParams.MaxTotalValidators = td.sandbox.TestStore.TotalValidators() + 1
+
+	pub1, _ := td.RandomBLSKeyPair()
+	pub2, _ := td.RandomBLSKeyPair()
+
+	t.Run("Creating the last validator should be ok", func(t *testing.T) {
+		fee, amt := td.randomAmountAndFee(senderBalance / 4)
+		trx := tx.NewBondTx(td.stamp500000, senderAcc.Sequence()+1, senderAddr,
+			pub1.Address(), pub1, amt, fee, "last validator")
+
+		assert.NoError(t, exe.Execute(trx, td.sandbox))
+		assert.Equal(t, td.sandbox.TestStore.TotalValidators(), td.sandbox.TestParams.MaxTotalValidators)
+	})
+
+	t.Run("Should fail, cap is reached", func(t *testing.T) {
+		fee, amt := td.randomAmountAndFee(senderBalance / 4)
+		trx := tx.NewBondTx(td.stamp500000, senderAcc.Sequence()+2, senderAddr,
+			pub2.Address(), pub2, amt, fee, "one more validator")
+
+		err := exe.Execute(trx, td.sandbox)
+		assert.Equal(t, errors.Code(err), errors.ErrTooManyValidators)
+		assert.Nil(t, td.sandbox.Validator(pub2.Address()))
+		assert.Equal(t, td.sandbox.TotalValidators(), td.sandbox.TestParams.MaxTotalValidators)
+	})
+
+	t.Run("Top-up of an existing validator should be ok", func(t *testing.T) {
+		fee, amt := td.randomAmountAndFee(senderBalance / 4)
+		trx := tx.NewBondTx(td.stamp500000, senderAcc.Sequence()+2, senderAddr,
+			pub1.Address(), nil, amt, fee, "top-up")
+
+		assert.NoError(t, exe.Execute(trx, td.sandbox))
+	})
+}
+
+func TestValidateBondPublicKey(t *testing.T) {
+	td := setup(t)
+
+	pub, _ := td.RandomBLSKeyPair()
+	otherPub, _ := td.RandomBLSKeyPair()
+	receiverAddr := pub.Address()
+
+	tests := []struct {
+		name            string
+		validatorExists bool
+		publicKey       *bls.PublicKey
+		reason          PublicKeyReason
+	}{
+		{"new validator, no public key", false, nil, PublicKeyMissing},
+		{"new validator, matched public key", false, pub, 0},
+		{"new validator, mismatched public key", false, otherPub, PublicKeyMismatched},
+		{"existing validator, no public key", true, nil, 0},
+		{"existing validator, matched public key", true, pub, PublicKeyUnexpected},
+		{"existing validator, mismatched public key", true, otherPub, PublicKeyMismatched},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			pld := &payload.BondPayload{
+				Sender:    td.RandomAddress(),
+				Receiver:  receiverAddr,
+				PublicKey: test.publicKey,
+				Stake:     1,
+			}
+
+			err := validateBondPublicKey(pld, test.validatorExists)
+			if test.reason == 0 {
+				assert.NoError(t, err)
+				return
+			}
+
+			assert.Equal(t, errors.Code(err), errors.ErrInvalidPublicKey)
+			var pubErr *PublicKeyError
+			require.ErrorAs(t, err, &pubErr)
+			assert.Equal(t, pubErr.Reason, test.reason)
+			assert.Equal(t, pubErr.Receiver, receiverAddr)
+			assert.Contains(t, err.Error(), test.reason.String())
+		})
+	}
+}
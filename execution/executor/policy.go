@@ -0,0 +1,51 @@
+package executor
+
+// Policy enumerates the checks that differ between the strict and non-strict modes.
+//
+// The strict mode is used to execute the transactions of a block.
+// The non-strict mode is used to check the transactions before adding them to
+// the transaction pool. Some transactions that can't be executed right now,
+// are accepted in non-strict mode and kept in the pool to be executed later.
+type Policy struct {
+	// RejectBondInCommittee rejects bond transactions for validators that are in the committee.
+	RejectBondInCommittee bool
+	// RejectBondJoiningCommittee rejects bond transactions for validators that
+	// are going to join the committee in the next height.
+	RejectBondJoiningCommittee bool
+	// RejectUnbondInCommittee rejects unbond transactions for validators that are in the committee.
+	RejectUnbondInCommittee bool
+	// RejectUnbondJoiningCommittee rejects unbond transactions for validators that
+	// are going to join the committee in the next height.
+	RejectUnbondJoiningCommittee bool
+	// CheckSortitionSequence checks the sequence number of sortition transactions.
+	// A validator might produce more than one sortition transaction before entering the committee.
+	CheckSortitionSequence bool
+	// CheckSortitionCommittee checks if the validator can join the committee.
+	CheckSortitionCommittee bool
+	// ExecuteSubsidy executes subsidy transactions. If not set, all subsidy transactions
+	// for the current height are considered valid, as there might be multiple proposals
+	// at the same height.
+	ExecuteSubsidy bool
+	// RejectUnfinalizedLockTime rejects transactions with a lock time in the future.
+	RejectUnfinalizedLockTime bool
+}
+
+// StrictPolicy returns the policy for executing the transactions of a block.
+func StrictPolicy() Policy {
+	return Policy{
+		RejectBondInCommittee:        true,
+		RejectBondJoiningCommittee:   true,
+		RejectUnbondInCommittee:      true,
+		RejectUnbondJoiningCommittee: true,
+		CheckSortitionSequence:       true,
+		CheckSortitionCommittee:      true,
+		ExecuteSubsidy:               true,
+		RejectUnfinalizedLockTime:    true,
+	}
+}
+
+// NonStrictPolicy returns the policy for checking the transactions
+// before adding them to the transaction pool.
+func NonStrictPolicy() Policy {
+	return Policy{}
+}
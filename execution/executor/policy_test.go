@@ -0,0 +1,97 @@
+package executor
+
+import (
+	"testing"
+
+	"github.com/pactus-project/pactus/types/tx"
+	"github.com/pactus-project/pactus/util/errors"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPolicies(t *testing.T) {
+	strict := StrictPolicy()
+	nonStrict := NonStrictPolicy()
+
+	tests := []struct {
+		name      string
+		strict    bool
+		nonStrict bool
+	}{
+		{"bond in committee", strict.RejectBondInCommittee, nonStrict.RejectBondInCommittee},
+		{"bond joining committee", strict.RejectBondJoiningCommittee, nonStrict.RejectBondJoiningCommittee},
+		{"unbond in committee", strict.RejectUnbondInCommittee, nonStrict.RejectUnbondInCommittee},
+		{"unbond joining committee", strict.RejectUnbondJoiningCommittee, nonStrict.RejectUnbondJoiningCommittee},
+		{"sortition sequence", strict.CheckSortitionSequence, nonStrict.CheckSortitionSequence},
+		{"sortition committee", strict.CheckSortitionCommittee, nonStrict.CheckSortitionCommittee},
+		{"subsidy", strict.ExecuteSubsidy, nonStrict.ExecuteSubsidy},
+		{"unfinalized lock time", strict.RejectUnfinalizedLockTime, nonStrict.RejectUnfinalizedLockTime},
+	}
+
+	for _, test := range tests {
+		assert.True(t, test.strict, "%v should be checked in strict mode", test.name)
+		assert.False(t, test.nonStrict, "%v should not be checked in non-strict mode", test.name)
+	}
+}
+
+func TestPolicyDrivesBondExecutor(t *testing.T) {
+	td := setup(t)
+
+	committeePub := td.sandbox.Committee().Proposer(0).PublicKey()
+	joiningPub, _ := td.RandomBLSKeyPair()
+	joiningVal := td.sandbox.MakeNewValidator(joiningPub)
+	joiningVal.UpdateLastJoinedHeight(td.sandbox.CurrentHeight())
+	td.sandbox.UpdateValidator(joiningVal)
+
+	bondTxs := func() (*tx.Tx, *tx.Tx) {
+		senderAddr, senderAcc := td.sandbox.TestStore.RandomTestAcc()
+		fee, amt := td.randomAmountAndFee(senderAcc.Balance() / 4)
+
+		inCommitteeTrx := tx.NewBondTx(td.stamp500000, senderAcc.Sequence()+1, senderAddr,
+			committeePub.Address(), nil, amt, fee, "inside committee")
+		joiningTrx := tx.NewBondTx(td.stamp500000, senderAcc.Sequence()+1, senderAddr,
+			joiningPub.Address(), nil, amt, fee, "joining committee")
+
+		return inCommitteeTrx, joiningTrx
+	}
+
+	t.Run("Strict policy", func(t *testing.T) {
+		exe := NewBondExecutor(StrictPolicy())
+		inCommitteeTrx, joiningTrx := bondTxs()
+
+		err := exe.Execute(inCommitteeTrx, td.sandbox)
+		assert.Equal(t, errors.Code(err), errors.ErrInvalidTx)
+		err = exe.Execute(joiningTrx, td.sandbox)
+		assert.Equal(t, errors.Code(err), errors.ErrInvalidTx)
+	})
+
+	t.Run("Rejecting bond in committee only", func(t *testing.T) {
+		policy := NonStrictPolicy()
+		policy.RejectBondInCommittee = true
+		exe := NewBondExecutor(policy)
+		inCommitteeTrx, joiningTrx := bondTxs()
+
+		err := exe.Execute(inCommitteeTrx, td.sandbox)
+		assert.Equal(t, errors.Code(err), errors.ErrInvalidTx)
+		assert.NoError(t, exe.Execute(joiningTrx, td.sandbox))
+	})
+
+	t.Run("Rejecting bond joining committee only", func(t *testing.T) {
+		policy := NonStrictPolicy()
+		policy.RejectBondJoiningCommittee = true
+		exe := NewBondExecutor(policy)
+		inCommitteeTrx, joiningTrx := bondTxs()
+
+		err := exe.Execute(joiningTrx, td.sandbox)
+		assert.Equal(t, errors.Code(err), errors.ErrInvalidTx)
+		assert.NoError(t, exe.Execute(inCommitteeTrx, td.sandbox))
+	})
+
+	t.Run("Non-strict policy", func(t *testing.T) {
+		exe := NewBondExecutor(NonStrictPolicy())
+		inCommitteeTrx, _ := bondTxs()
+		assert.NoError(t, exe.Execute(inCommitteeTrx, td.sandbox))
+
+		_, joiningTrx := bondTxs()
+		assert.NoError(t, exe.Execute(joiningTrx, td.sandbox))
+	})
+}
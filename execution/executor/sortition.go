@@ -11,11 +11,11 @@ import (
 )
 
 type SortitionExecutor struct {
-	strict bool
+	policy Policy
 }
 
-func NewSortitionExecutor(strict bool) *SortitionExecutor {
-	return &SortitionExecutor{strict: strict}
+func NewSortitionExecutor(policy Policy) *SortitionExecutor {
+	return &SortitionExecutor{policy: policy}
 }
 
 func (e *SortitionExecutor) Execute(trx *tx.Tx, sb sandbox.Sandbox) error {
@@ -26,6 +26,12 @@ func (e *SortitionExecutor) Execute(trx *tx.Tx, sb sandbox.Sandbox) error {
 		return errors.Errorf(errors.ErrInvalidAddress,
 			"unable to retrieve validator")
 	}
+	// Jailed validators are not allowed to join the committee,
+	// even if they have a valid proof.
+	if val.IsJailed() {
+		return errors.Errorf(errors.ErrInvalidTx,
+			"validator has jailed at height %v", val.JailedHeight())
+	}
 
 	if sb.CurrentHeight()-val.LastBondingHeight() < sb.Params().BondInterval {
 		return errors.Errorf(errors.ErrInvalidHeight,
@@ -37,14 +43,14 @@ func (e *SortitionExecutor) Execute(trx *tx.Tx, sb sandbox.Sandbox) error {
 	if !ok {
 		return errors.Error(errors.ErrInvalidProof)
 	}
-	if e.strict {
-		// A validator might produce more than one sortition transaction
-		// before entering into the committee
-		// In non-strict mode we don't check the sequence number
-		if val.Sequence()+1 != trx.Sequence() {
-			return errors.Errorf(errors.ErrInvalidSequence,
-				"expected: %v, got: %v", val.Sequence()+1, trx.Sequence())
-		}
+	// A validator might produce more than one sortition transaction
+	// before entering into the committee
+	// In non-strict mode we don't check the sequence number
+	if e.policy.CheckSortitionSequence && val.Sequence()+1 != trx.Sequence() {
+		return errors.Errorf(errors.ErrInvalidSequence,
+			"expected: %v, got: %v", val.Sequence()+1, trx.Sequence())
+	}
+	if e.policy.CheckSortitionCommittee {
 		if sb.Committee().Size() >= sb.Params().CommitteeSize {
 			if err := e.joinCommittee(sb, val); err != nil {
 				return err
@@ -77,19 +83,21 @@ func (e *SortitionExecutor) joinCommittee(sb sandbox.Sandbox,
 	joiningPower := int64(0)
 	committee := sb.Committee()
 	currentHeight := sb.CurrentHeight()
+	activationInterval := sb.Params().StakeActivationInterval
 	sb.IterateValidators(func(val *validator.Validator, updated bool) {
 		if val.LastJoinedHeight() == currentHeight {
 			if !committee.Contains(val.Address()) {
-				joiningPower += val.Power()
+				joiningPower += val.EffectivePower(currentHeight, activationInterval)
 				joiningNum++
 			}
 		}
 	})
 	if !committee.Contains(val.Address()) {
-		joiningPower += val.Power()
+		joiningPower += val.EffectivePower(currentHeight, activationInterval)
 		joiningNum++
 	}
-	if joiningPower >= (committee.TotalPower() / 3) {
+	committeePower := committee.TotalEffectivePower(currentHeight, activationInterval)
+	if joiningPower >= (committeePower / 3) {
 		return errors.Errorf(errors.ErrInvalidTx,
 			"in each height only 1/3 of stake can join")
 	}
@@ -100,9 +108,9 @@ func (e *SortitionExecutor) joinCommittee(sb sandbox.Sandbox,
 	})
 	leavingPower := int64(0)
 	for i := 0; i < joiningNum; i++ {
-		leavingPower += vals[i].Power()
+		leavingPower += vals[i].EffectivePower(currentHeight, activationInterval)
 	}
-	if leavingPower >= (committee.TotalPower() / 3) {
+	if leavingPower >= (committeePower / 3) {
 		return errors.Errorf(errors.ErrInvalidTx,
 			"in each height only 1/3 of stake can leave")
 	}
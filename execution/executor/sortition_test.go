@@ -3,6 +3,7 @@ package executor
 import (
 	"testing"
 
+	"github.com/pactus-project/pactus/committee"
 	"github.com/pactus-project/pactus/types/tx"
 	"github.com/pactus-project/pactus/types/validator"
 	"github.com/pactus-project/pactus/util/errors"
@@ -11,7 +12,7 @@ import (
 
 func TestExecuteSortitionTx(t *testing.T) {
 	td := setup(t)
-	exe := NewSortitionExecutor(true)
+	exe := NewSortitionExecutor(StrictPolicy())
 
 	existingVal := td.sandbox.TestStore.RandomTestVal()
 	pub, _ := td.RandomBLSKeyPair()
@@ -22,6 +23,7 @@ func TestExecuteSortitionTx(t *testing.T) {
 	acc.SubtractFromBalance(amt + fee)
 	td.sandbox.UpdateAccount(accAddr, acc)
 	td.sandbox.UpdateValidator(newVal)
+	td.sandbox.UpdatePowerDelta(amt + fee)
 
 	proof := td.RandomProof()
 
@@ -79,8 +81,8 @@ func TestExecuteSortitionTx(t *testing.T) {
 
 func TestSortitionNonStrictMode(t *testing.T) {
 	td := setup(t)
-	exe1 := NewSortitionExecutor(true)
-	exe2 := NewSortitionExecutor(false)
+	exe1 := NewSortitionExecutor(StrictPolicy())
+	exe2 := NewSortitionExecutor(NonStrictPolicy())
 
 	val := td.sandbox.TestStore.RandomTestVal()
 	proof := td.RandomProof()
@@ -91,10 +93,30 @@ func TestSortitionNonStrictMode(t *testing.T) {
 	assert.NoError(t, exe2.Execute(trx, td.sandbox))
 }
 
+// TestSortitionJailed checks if a jailed validator tries to join the committee.
+// It should be rejected in both strict and non-strict modes.
+func TestSortitionJailed(t *testing.T) {
+	td := setup(t)
+	exe1 := NewSortitionExecutor(StrictPolicy())
+	exe2 := NewSortitionExecutor(NonStrictPolicy())
+
+	pub, _ := td.RandomBLSKeyPair()
+	val := td.sandbox.MakeNewValidator(pub)
+	val.AddToStake(1e9)
+	val.UpdateLastBondingHeight(td.sandbox.CurrentHeight() - td.sandbox.Params().BondInterval)
+	val.UpdateJailedHeight(td.sandbox.CurrentHeight())
+	td.sandbox.UpdateValidator(val)
+
+	td.sandbox.TestAcceptSortition = true
+	trx := tx.NewSortitionTx(td.stamp500000, val.Sequence()+1, val.Address(), td.RandomProof())
+	assert.Equal(t, errors.Code(exe1.Execute(trx, td.sandbox)), errors.ErrInvalidTx)
+	assert.Equal(t, errors.Code(exe2.Execute(trx, td.sandbox)), errors.ErrInvalidTx)
+}
+
 func TestChangePower1(t *testing.T) {
 	td := setup(t)
 
-	exe := NewSortitionExecutor(true)
+	exe := NewSortitionExecutor(StrictPolicy())
 
 	// Let's create validators first
 	pub1, _ := td.RandomBLSKeyPair()
@@ -131,7 +153,7 @@ func TestChangePower1(t *testing.T) {
 func TestChangePower2(t *testing.T) {
 	td := setup(t)
 
-	exe := NewSortitionExecutor(true)
+	exe := NewSortitionExecutor(StrictPolicy())
 
 	// Let's create validators first
 	pub1, _ := td.RandomBLSKeyPair()
@@ -174,12 +196,55 @@ func TestChangePower2(t *testing.T) {
 	assert.NoError(t, exe.Execute(trx4, td.sandbox))
 }
 
+// TestChangePowerActivatingStake tests that the joining power is compared
+// against the effective power of the committee, not the recorded one.
+func TestChangePowerActivatingStake(t *testing.T) {
+	td := setup(t)
+
+	exe := NewSortitionExecutor(StrictPolicy())
+	bondInterval := td.sandbox.Params().BondInterval
+	currentHeight := td.sandbox.CurrentHeight()
+
+	// Half of the stake of each committee member is still inactive.
+	vals := make([]*validator.Validator, 4)
+	for i := 0; i < 4; i++ {
+		val, _ := td.GenerateTestValidator(int32(i))
+		val.SubtractFromStake(val.Stake())
+		val.AddToStake(10 * 1e9)
+		val.UpdateActivatingStake(10 * 1e9)
+		val.UpdateLastBondingHeight(currentHeight - bondInterval)
+		val.UpdateLastJoinedHeight(1)
+		vals[i] = val
+	}
+	cms, err := committee.NewCommittee(vals, 4, vals[0].Address())
+	assert.NoError(t, err)
+	td.sandbox.TestCommittee = cms
+
+	pub, _ := td.RandomBLSKeyPair()
+	val := td.sandbox.MakeNewValidator(pub)
+	val.AddToStake(8 * 1e9)
+	val.UpdateLastBondingHeight(currentHeight - 2*bondInterval)
+	td.sandbox.UpdateValidator(val)
+	proof := td.RandomProof()
+
+	td.sandbox.TestParams.CommitteeSize = 4
+	td.sandbox.TestAcceptSortition = true
+	td.sandbox.TestParams.StakeActivationInterval = 2 * bondInterval
+	trx := tx.NewSortitionTx(td.stamp500000, val.Sequence()+1, val.Address(), proof)
+	err = exe.Execute(trx, td.sandbox)
+	assert.Equal(t, errors.Code(err), errors.ErrInvalidTx)
+
+	// Without the activation interval, the whole stake of the committee is effective.
+	td.sandbox.TestParams.StakeActivationInterval = 0
+	assert.NoError(t, exe.Execute(trx, td.sandbox))
+}
+
 // TestOldestDidNotPropose tests if the oldest validator in the committee had
 // chance to propose a block or not.
 func TestOldestDidNotPropose(t *testing.T) {
 	td := setup(t)
 
-	exe := NewSortitionExecutor(true)
+	exe := NewSortitionExecutor(StrictPolicy())
 
 	// Let's create validators first
 	vals := make([]*validator.Validator, 9)
@@ -10,17 +10,17 @@ import (
 
 type TransferExecutor struct {
 	fee    int64
-	strict bool
+	policy Policy
 }
 
-func NewTransferExecutor(strict bool) *TransferExecutor {
-	return &TransferExecutor{strict: strict}
+func NewTransferExecutor(policy Policy) *TransferExecutor {
+	return &TransferExecutor{policy: policy}
 }
 
 func (e *TransferExecutor) Execute(trx *tx.Tx, sb sandbox.Sandbox) error {
 	pld := trx.Payload().(*payload.TransferPayload)
 
-	if !e.strict && trx.IsSubsidyTx() {
+	if !e.policy.ExecuteSubsidy && trx.IsSubsidyTx() {
 		// In non-strict mode, all subsidy transactions for the current height are considered valid.
 		// There may be more than one valid subsidy transaction per height
 		// as there might be multiple proposals at the same height.
@@ -42,6 +42,7 @@ func (td *testData) checkTotalCoin(t *testing.T, fee int64) {
 		total += val.Stake()
 	}
 	assert.Equal(t, total+fee, int64(21000000*1e9))
+	assert.NoError(t, td.sandbox.VerifyConsistency())
 }
 
 func (td *testData) randomAmountAndFee(max int64) (int64, int64) {
@@ -52,7 +53,7 @@ func (td *testData) randomAmountAndFee(max int64) (int64, int64) {
 
 func TestExecuteTransferTx(t *testing.T) {
 	td := setup(t)
-	exe := NewTransferExecutor(true)
+	exe := NewTransferExecutor(StrictPolicy())
 
 	senderAddr, senderAcc := td.sandbox.TestStore.RandomTestAcc()
 	senderBalance := senderAcc.Balance()
@@ -98,7 +99,7 @@ func TestExecuteTransferTx(t *testing.T) {
 
 func TestTransferToSelf(t *testing.T) {
 	td := setup(t)
-	exe := NewTransferExecutor(true)
+	exe := NewTransferExecutor(StrictPolicy())
 
 	senderAddr, senderAcc := td.sandbox.TestStore.RandomTestAcc()
 	senderBalance := senderAcc.Balance()
@@ -113,8 +114,8 @@ func TestTransferToSelf(t *testing.T) {
 
 func TestTransferNonStrictMode(t *testing.T) {
 	td := setup(t)
-	exe1 := NewTransferExecutor(true)
-	exe2 := NewTransferExecutor(false)
+	exe1 := NewTransferExecutor(StrictPolicy())
+	exe2 := NewTransferExecutor(NonStrictPolicy())
 
 	receiver1 := td.RandomAddress()
 
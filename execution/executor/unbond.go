@@ -8,11 +8,11 @@ import (
 )
 
 type UnbondExecutor struct {
-	strict bool
+	policy Policy
 }
 
-func NewUnbondExecutor(strict bool) *UnbondExecutor {
-	return &UnbondExecutor{strict: strict}
+func NewUnbondExecutor(policy Policy) *UnbondExecutor {
+	return &UnbondExecutor{policy: policy}
 }
 
 func (e *UnbondExecutor) Execute(trx *tx.Tx, sb sandbox.Sandbox) error {
@@ -31,24 +31,22 @@ func (e *UnbondExecutor) Execute(trx *tx.Tx, sb sandbox.Sandbox) error {
 		return errors.Errorf(errors.ErrInvalidHeight,
 			"validator has unbonded at height %v", val.UnbondingHeight())
 	}
-	if e.strict {
-		// In strict mode, the unbond transaction will be rejected if the
-		// validator is in the committee.
-		// In non-strict mode, we accept it and keep it inside the transaction pool to
-		// process it when the validator leaves the committee.
-		if sb.Committee().Contains(pld.Validator) {
-			return errors.Errorf(errors.ErrInvalidTx,
-				"validator %v is in committee", pld.Validator)
-		}
+	// In strict mode, the unbond transaction will be rejected if the
+	// validator is in the committee.
+	// In non-strict mode, we accept it and keep it inside the transaction pool to
+	// process it when the validator leaves the committee.
+	if e.policy.RejectUnbondInCommittee && sb.Committee().Contains(pld.Validator) {
+		return errors.Errorf(errors.ErrInvalidTx,
+			"validator %v is in committee", pld.Validator)
+	}
 
-		// In strict mode, unbond transactions will be rejected if a validator is
-		// going to be in the committee for the next height.
-		// In non-strict mode, we accept it and keep it inside the transaction pool to
-		// process it when the validator leaves the committee.
-		if val.LastJoinedHeight() == sb.CurrentHeight() {
-			return errors.Errorf(errors.ErrInvalidHeight,
-				"validator %v joins committee in the next height", pld.Validator)
-		}
+	// In strict mode, unbond transactions will be rejected if a validator is
+	// going to be in the committee for the next height.
+	// In non-strict mode, we accept it and keep it inside the transaction pool to
+	// process it when the validator leaves the committee.
+	if e.policy.RejectUnbondJoiningCommittee && val.LastJoinedHeight() == sb.CurrentHeight() {
+		return errors.Errorf(errors.ErrInvalidHeight,
+			"validator %v joins committee in the next height", pld.Validator)
 	}
 
 	val.IncSequence()
@@ -57,7 +55,7 @@ func (e *UnbondExecutor) Execute(trx *tx.Tx, sb sandbox.Sandbox) error {
 	// At this point, the validator's power is zero.
 	// However, we know the validator's stake.
 	// So, we can update the power delta with the negative of the validator's stake.
-	sb.UpdatePowerDelta(-1 * val.Power())
+	sb.UpdatePowerDelta(-1 * val.Stake())
 	sb.UpdateValidator(val)
 
 	return nil
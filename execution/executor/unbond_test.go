@@ -10,7 +10,7 @@ import (
 
 func TestExecuteUnbondTx(t *testing.T) {
 	td := setup(t)
-	exe := NewUnbondExecutor(true)
+	exe := NewUnbondExecutor(StrictPolicy())
 
 	pub, _ := td.RandomBLSKeyPair()
 	valAddr := pub.Address()
@@ -61,13 +61,33 @@ func TestExecuteUnbondTx(t *testing.T) {
 	td.checkTotalCoin(t, 0)
 }
 
+// TestUnbondPowerDelta checks that unbonding decreases the total power by
+// the stake of the validator.
+func TestUnbondPowerDelta(t *testing.T) {
+	td := setup(t)
+	exe := NewUnbondExecutor(StrictPolicy())
+
+	pub, _ := td.RandomBLSKeyPair()
+	val := td.sandbox.MakeNewValidator(pub)
+	val.AddToStake(1e9)
+	td.sandbox.UpdateValidator(val)
+	td.sandbox.UpdatePowerDelta(1e9) // bonded in the same block
+
+	trx := tx.NewUnbondTx(td.stamp500000, val.Sequence()+1, val.Address(), "unbond")
+	assert.NoError(t, exe.Execute(trx, td.sandbox))
+
+	assert.Zero(t, td.sandbox.Validator(val.Address()).Power())
+	assert.Zero(t, td.sandbox.PowerDelta())
+	assert.NoError(t, td.sandbox.VerifyConsistency())
+}
+
 // TestUnbondInsideCommittee checks if a validator inside the committee tries to
 // unbond the stake.
 // In non-strict mode it should be accepted.
 func TestUnbondInsideCommittee(t *testing.T) {
 	td := setup(t)
-	exe1 := NewUnbondExecutor(true)
-	exe2 := NewUnbondExecutor(false)
+	exe1 := NewUnbondExecutor(StrictPolicy())
+	exe2 := NewUnbondExecutor(NonStrictPolicy())
 
 	val := td.sandbox.Committee().Proposer(0)
 	trx := tx.NewUnbondTx(td.stamp500000, val.Sequence()+1, val.Address(), "")
@@ -81,8 +101,8 @@ func TestUnbondInsideCommittee(t *testing.T) {
 // In non-strict mode it should be accepted.
 func TestUnbondJoiningCommittee(t *testing.T) {
 	td := setup(t)
-	exe1 := NewUnbondExecutor(true)
-	exe2 := NewUnbondExecutor(false)
+	exe1 := NewUnbondExecutor(StrictPolicy())
+	exe2 := NewUnbondExecutor(NonStrictPolicy())
 	pub, _ := td.RandomBLSKeyPair()
 
 	val := td.sandbox.MakeNewValidator(pub)
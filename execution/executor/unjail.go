@@ -0,0 +1,54 @@
+package executor
+
+import (
+	"github.com/pactus-project/pactus/sandbox"
+	"github.com/pactus-project/pactus/types/tx"
+	"github.com/pactus-project/pactus/types/tx/payload"
+	"github.com/pactus-project/pactus/util/errors"
+)
+
+type UnjailExecutor struct {
+	policy Policy
+}
+
+func NewUnjailExecutor(policy Policy) *UnjailExecutor {
+	return &UnjailExecutor{policy: policy}
+}
+
+func (e *UnjailExecutor) Execute(trx *tx.Tx, sb sandbox.Sandbox) error {
+	pld := trx.Payload().(*payload.UnjailPayload)
+
+	val := sb.Validator(pld.Signer())
+	if val == nil {
+		return errors.Errorf(errors.ErrInvalidAddress,
+			"unable to retrieve validator")
+	}
+	if val.Sequence()+1 != trx.Sequence() {
+		return errors.Errorf(errors.ErrInvalidSequence,
+			"expected: %v, got: %v", val.Sequence()+1, trx.Sequence())
+	}
+	if !val.IsJailed() {
+		return errors.Errorf(errors.ErrInvalidTx,
+			"validator %v is not jailed", pld.Validator)
+	}
+	// A jailed validator leaves the committee, so it can't prove its uptime
+	// until it is unjailed. The downtime is cleared by serving the cooldown,
+	// and the absent counter starts from zero again.
+	if sb.CurrentHeight()-val.JailedHeight() < sb.Params().JailCooldown {
+		return errors.Errorf(errors.ErrInvalidHeight,
+			"validator has jailed at height %v", val.JailedHeight())
+	}
+
+	val.IncSequence()
+	val.ResetAbsentCount()
+	val.UpdateJailedHeight(0)
+
+	sb.UpdateValidator(val)
+
+	return nil
+}
+
+// Fee will return unjail execution fee.
+func (e *UnjailExecutor) Fee() int64 {
+	return 0
+}
@@ -0,0 +1,65 @@
+package executor
+
+import (
+	"testing"
+
+	"github.com/pactus-project/pactus/types/tx"
+	"github.com/pactus-project/pactus/util/errors"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestExecuteUnjailTx(t *testing.T) {
+	td := setup(t)
+	exe := NewUnjailExecutor(StrictPolicy())
+
+	pub, _ := td.RandomBLSKeyPair()
+	valAddr := pub.Address()
+	val := td.sandbox.MakeNewValidator(pub)
+	val.AddToStake(1e9)
+	val.IncAbsentCount()
+	td.sandbox.UpdateValidator(val)
+
+	cooldown := td.sandbox.Params().JailCooldown
+	curHeight := td.sandbox.CurrentHeight()
+
+	t.Run("Should fail, Invalid validator", func(t *testing.T) {
+		trx := tx.NewUnjailTx(td.stamp500000, val.Sequence()+1, td.RandomAddress(), "invalid validator")
+		assert.Equal(t, errors.Code(exe.Execute(trx, td.sandbox)), errors.ErrInvalidAddress)
+	})
+
+	t.Run("Should fail, Invalid sequence", func(t *testing.T) {
+		trx := tx.NewUnjailTx(td.stamp500000, val.Sequence()+2, valAddr, "invalid sequence")
+		assert.Equal(t, errors.Code(exe.Execute(trx, td.sandbox)), errors.ErrInvalidSequence)
+	})
+
+	t.Run("Should fail, Not jailed", func(t *testing.T) {
+		trx := tx.NewUnjailTx(td.stamp500000, val.Sequence()+1, valAddr, "not jailed")
+		assert.Equal(t, errors.Code(exe.Execute(trx, td.sandbox)), errors.ErrInvalidTx)
+	})
+
+	t.Run("Should fail, Early unjail", func(t *testing.T) {
+		val.UpdateJailedHeight(curHeight - cooldown + 1)
+		td.sandbox.UpdateValidator(val)
+
+		trx := tx.NewUnjailTx(td.stamp500000, val.Sequence()+1, valAddr, "early unjail")
+		assert.Equal(t, errors.Code(exe.Execute(trx, td.sandbox)), errors.ErrInvalidHeight)
+		assert.True(t, td.sandbox.Validator(valAddr).IsJailed())
+	})
+
+	t.Run("Ok", func(t *testing.T) {
+		val.UpdateJailedHeight(curHeight - cooldown)
+		td.sandbox.UpdateValidator(val)
+
+		trx := tx.NewUnjailTx(td.stamp500000, val.Sequence()+1, valAddr, "Ok")
+		assert.NoError(t, exe.Execute(trx, td.sandbox))
+
+		// Execute again, should fail
+		assert.Error(t, exe.Execute(trx, td.sandbox))
+	})
+
+	assert.False(t, td.sandbox.Validator(valAddr).IsJailed())
+	assert.Zero(t, td.sandbox.Validator(valAddr).AbsentCount())
+	assert.Equal(t, td.sandbox.Validator(valAddr).Stake(), int64(1e9))
+	assert.Zero(t, td.sandbox.PowerDelta())
+	assert.Zero(t, exe.Fee())
+}
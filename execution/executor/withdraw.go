@@ -9,11 +9,11 @@ import (
 
 type WithdrawExecutor struct {
 	fee    int64
-	strict bool
+	policy Policy
 }
 
-func NewWithdrawExecutor(strict bool) *WithdrawExecutor {
-	return &WithdrawExecutor{strict: strict}
+func NewWithdrawExecutor(policy Policy) *WithdrawExecutor {
+	return &WithdrawExecutor{policy: policy}
 }
 
 func (e *WithdrawExecutor) Execute(trx *tx.Tx, sb sandbox.Sandbox) error {
@@ -10,7 +10,7 @@ import (
 
 func TestExecuteWithdrawTx(t *testing.T) {
 	td := setup(t)
-	exe := NewWithdrawExecutor(true)
+	exe := NewWithdrawExecutor(StrictPolicy())
 
 	addr := td.RandomAddress()
 	pub, _ := td.RandomBLSKeyPair()
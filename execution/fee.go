@@ -0,0 +1,41 @@
+package execution
+
+import "github.com/pactus-project/pactus/types/param"
+
+// FeeShares shows how the accumulated fee of a block is distributed.
+type FeeShares struct {
+	// Proposer is paid to the proposer through the subsidy transaction.
+	Proposer int64
+	// Treasury is credited to the treasury account.
+	Treasury int64
+	// Burn is not credited to any account, so it decreases the total supply.
+	Burn int64
+}
+
+// SplitFee splits the accumulated fee of a block based on the fee policy parameters.
+// The treasury and burned shares are rounded down and the remainder goes to the proposer.
+// By default, all the fee goes to the proposer.
+func SplitFee(fee int64, params param.Params) FeeShares {
+	treasury := fraction(fee, params.FeeTreasuryFraction)
+	burn := fraction(fee, params.FeeBurnFraction)
+	if treasury+burn > fee {
+		// Misconfigured fractions, the sum of them is more than one.
+		burn = fee - treasury
+	}
+
+	return FeeShares{
+		Proposer: fee - treasury - burn,
+		Treasury: treasury,
+		Burn:     burn,
+	}
+}
+
+func fraction(amount int64, f float64) int64 {
+	if f <= 0 {
+		return 0
+	}
+	if f >= 1 {
+		return amount
+	}
+	return int64(float64(amount) * f)
+}
@@ -0,0 +1,35 @@
+package execution
+
+import (
+	"testing"
+
+	"github.com/pactus-project/pactus/types/param"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSplitFee(t *testing.T) {
+	tests := []struct {
+		name             string
+		treasuryFraction float64
+		burnFraction     float64
+		expected         FeeShares
+	}{
+		{"to proposer", 0, 0, FeeShares{Proposer: 1000}},
+		{"to treasury", 1, 0, FeeShares{Treasury: 1000}},
+		{"burn", 0, 1, FeeShares{Burn: 1000}},
+		{"split", 0.25, 0.5, FeeShares{Proposer: 250, Treasury: 250, Burn: 500}},
+		{"rounded down", 0.3333, 0.3333, FeeShares{Proposer: 334, Treasury: 333, Burn: 333}},
+		{"negative fractions", -0.5, -0.5, FeeShares{Proposer: 1000}},
+		{"more than one", 0.6, 0.6, FeeShares{Treasury: 600, Burn: 400}},
+	}
+
+	for _, test := range tests {
+		params := param.DefaultParams()
+		params.FeeTreasuryFraction = test.treasuryFraction
+		params.FeeBurnFraction = test.burnFraction
+
+		shares := SplitFee(1000, params)
+		assert.Equal(t, test.expected, shares, test.name)
+		assert.Equal(t, int64(1000), shares.Proposer+shares.Treasury+shares.Burn, test.name)
+	}
+}
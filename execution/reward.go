@@ -0,0 +1,88 @@
+package execution
+
+import (
+	"math/big"
+
+	"github.com/pactus-project/pactus/crypto"
+	"github.com/pactus-project/pactus/sandbox"
+	"github.com/pactus-project/pactus/types/block"
+	"github.com/pactus-project/pactus/types/validator"
+	"github.com/pactus-project/pactus/util"
+)
+
+// CommitteeReward returns the part of the block reward that is distributed
+// among the committee members who signed the previous block.
+// The remainder of the block reward goes to the proposer.
+func CommitteeReward(blockReward int64, f float64) int64 {
+	return fraction(blockReward, f)
+}
+
+// DistributeCommitteeReward distributes the committee reward among the
+// signers of the given certificate, proportional to their effective power.
+// The effective power is taken at the height of the certified block, so the
+// signers are weighted by the same power that their votes had.
+// The reward is paid from the treasury to the accounts of the signers.
+// The amounts are rounded down and the committers are processed in the
+// order of the certificate, so the result is deterministic.
+// It returns the total distributed amount, which should be subtracted from
+// the proposer's reward in the subsidy transaction.
+func DistributeCommitteeReward(cert *block.Certificate, sb sandbox.Sandbox) int64 {
+	if cert == nil {
+		return 0
+	}
+
+	committeeReward := CommitteeReward(sb.Params().BlockReward, sb.Params().CommitteeRewardFraction)
+	if committeeReward == 0 {
+		return 0
+	}
+
+	height := sb.CurrentHeight() - 1
+	activationInterval := sb.Params().StakeActivationInterval
+
+	signers := make([]*validator.Validator, 0, len(cert.Committers()))
+	powers := make([]int64, 0, len(cert.Committers()))
+	totalPower := int64(0)
+	for _, num := range cert.Committers() {
+		if util.Contains(cert.Absentees(), num) {
+			continue
+		}
+		val := sb.ValidatorByNumber(num)
+		if val == nil {
+			continue
+		}
+		power := val.EffectivePower(height, activationInterval)
+		if power <= 0 {
+			continue
+		}
+		signers = append(signers, val)
+		powers = append(powers, power)
+		totalPower += power
+	}
+
+	distributed := int64(0)
+	for i, val := range signers {
+		// reward = committeeReward * power / totalPower
+		// It is calculated using big integers to prevent overflow.
+		reward := new(big.Int).Mul(big.NewInt(committeeReward), big.NewInt(powers[i]))
+		reward.Quo(reward, big.NewInt(totalPower))
+		amt := reward.Int64()
+		if amt == 0 {
+			continue
+		}
+
+		acc := sb.Account(val.Address())
+		if acc == nil {
+			acc = sb.MakeNewAccount(val.Address())
+		}
+		acc.AddToBalance(amt)
+		sb.UpdateAccount(val.Address(), acc)
+
+		distributed += amt
+	}
+
+	treasury := sb.Account(crypto.TreasuryAddress)
+	treasury.SubtractFromBalance(distributed)
+	sb.UpdateAccount(crypto.TreasuryAddress, treasury)
+
+	return distributed
+}
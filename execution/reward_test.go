@@ -0,0 +1,125 @@
+package execution
+
+import (
+	"testing"
+
+	"github.com/pactus-project/pactus/crypto"
+	"github.com/pactus-project/pactus/sandbox"
+	"github.com/pactus-project/pactus/types/block"
+	"github.com/pactus-project/pactus/util/testsuite"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCommitteeReward(t *testing.T) {
+	assert.Equal(t, CommitteeReward(1e9, 0), int64(0))
+	assert.Equal(t, CommitteeReward(1e9, 0.3), int64(3e8))
+	assert.Equal(t, CommitteeReward(1e9, 1), int64(1e9))
+	assert.Equal(t, CommitteeReward(1e9, -0.1), int64(0))
+	assert.Equal(t, CommitteeReward(1e9, 1.1), int64(1e9))
+}
+
+func totalBalance(sb *sandbox.MockSandbox) int64 {
+	total := int64(0)
+	for _, acc := range sb.TestStore.Accounts {
+		total += acc.Balance()
+	}
+	return total
+}
+
+func TestDistributeCommitteeReward(t *testing.T) {
+	ts := testsuite.NewTestSuite(t)
+
+	sb := sandbox.MockingSandbox(ts)
+	sb.TestParams.BlockReward = 1e9
+	sb.TestParams.CommitteeRewardFraction = 0.3
+
+	// The validators have different stakes: 1, 2, ... 7 coins
+	committers := sb.TestCommittee.Committers()
+	for i, num := range committers {
+		val := sb.ValidatorByNumber(num)
+		val.SubtractFromStake(val.Stake())
+		val.AddToStake(int64(i+1) * 1e9)
+		sb.UpdateValidator(val)
+	}
+	absentee := committers[6]
+	cert := block.NewCertificate(0, committers, []int32{absentee}, nil)
+
+	t.Run("Disabled", func(t *testing.T) {
+		sb.TestParams.CommitteeRewardFraction = 0
+		defer func() { sb.TestParams.CommitteeRewardFraction = 0.3 }()
+
+		assert.Zero(t, DistributeCommitteeReward(cert, sb))
+	})
+
+	t.Run("No certificate", func(t *testing.T) {
+		assert.Zero(t, DistributeCommitteeReward(nil, sb))
+	})
+
+	t.Run("Distributing proportional to power", func(t *testing.T) {
+		balances := make(map[int32]int64)
+		for _, num := range committers {
+			balances[num] = sb.Account(sb.ValidatorByNumber(num).Address()).Balance()
+		}
+		treasuryBalance := sb.Account(crypto.TreasuryAddress).Balance()
+		total := totalBalance(sb)
+
+		distributed := DistributeCommitteeReward(cert, sb)
+
+		// The signers have 21 coins in total. Amounts are rounded down.
+		expected := []int64{
+			14285714, // 3e8 * 1 / 21
+			28571428, // 3e8 * 2 / 21
+			42857142, // 3e8 * 3 / 21
+			57142857, // 3e8 * 4 / 21
+			71428571, // 3e8 * 5 / 21
+			85714285, // 3e8 * 6 / 21
+			0,        // absentee
+		}
+		for i, num := range committers {
+			acc := sb.Account(sb.ValidatorByNumber(num).Address())
+			assert.Equal(t, acc.Balance()-balances[num], expected[i], "committer %v", num)
+		}
+
+		// The remainder of the rounding goes to the proposer.
+		assert.Equal(t, distributed, int64(299999997))
+		assert.Equal(t, sb.TestParams.BlockReward-distributed, int64(700000003))
+		assert.Equal(t, sb.Account(crypto.TreasuryAddress).Balance(), treasuryBalance-distributed)
+		assert.Equal(t, totalBalance(sb), total)
+	})
+
+	t.Run("Stake is not activated yet", func(t *testing.T) {
+		sb.TestParams.StakeActivationInterval = 100
+		defer func() { sb.TestParams.StakeActivationInterval = 0 }()
+
+		// The second committer has 2 coins, but it has bonded 1 coin
+		// at the certified height, so it has the same effective power as the first one.
+		cert := block.NewCertificate(0, committers, committers[2:], nil)
+		addr0 := sb.ValidatorByNumber(committers[0]).Address()
+		val := sb.ValidatorByNumber(committers[1])
+		val.UpdateActivatingStake(1e9)
+		val.UpdateLastBondingHeight(sb.CurrentHeight() - 1)
+		sb.UpdateValidator(val)
+		defer func() {
+			val.UpdateActivatingStake(0)
+			sb.UpdateValidator(val)
+		}()
+
+		balance0 := sb.Account(addr0).Balance()
+		balance1 := sb.Account(val.Address()).Balance()
+
+		distributed := DistributeCommitteeReward(cert, sb)
+		assert.Equal(t, distributed, int64(3e8))
+		assert.Equal(t, sb.Account(addr0).Balance()-balance0, int64(15e7))
+		assert.Equal(t, sb.Account(val.Address()).Balance()-balance1, int64(15e7))
+	})
+
+	t.Run("Signer without account", func(t *testing.T) {
+		cert := block.NewCertificate(0, committers, committers[1:], nil)
+		addr := sb.ValidatorByNumber(committers[0]).Address()
+		delete(sb.TestStore.Accounts, addr)
+
+		distributed := DistributeCommitteeReward(cert, sb)
+		assert.Equal(t, distributed, int64(3e8))
+		assert.Equal(t, sb.Account(addr).Balance(), int64(3e8))
+	})
+}
@@ -0,0 +1,37 @@
+package execution
+
+import (
+	"github.com/pactus-project/pactus/sandbox"
+	"github.com/pactus-project/pactus/types/block"
+	"github.com/pactus-project/pactus/util"
+)
+
+// UpdateUptime updates the absent counter of the committers based on the
+// given certificate and jails the validators that exceeded the downtime threshold.
+// Jailed validators keep their stake, but they leave the committee at the end of
+// the block and can't join it again until they are unjailed.
+func UpdateUptime(cert *block.Certificate, sb sandbox.Sandbox) {
+	if cert == nil || !sb.Params().IsJailingEnabled() {
+		return
+	}
+
+	for _, num := range cert.Committers() {
+		val := sb.ValidatorByNumber(num)
+		if val == nil {
+			continue
+		}
+
+		if util.Contains(cert.Absentees(), num) {
+			val.IncAbsentCount()
+			if !val.IsJailed() && val.AbsentCount() >= sb.Params().JailThreshold {
+				val.UpdateJailedHeight(sb.CurrentHeight())
+			}
+		} else {
+			if val.AbsentCount() == 0 {
+				continue
+			}
+			val.ResetAbsentCount()
+		}
+		sb.UpdateValidator(val)
+	}
+}
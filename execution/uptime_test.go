@@ -0,0 +1,87 @@
+package execution
+
+import (
+	"testing"
+
+	"github.com/pactus-project/pactus/sandbox"
+	"github.com/pactus-project/pactus/types/block"
+	"github.com/pactus-project/pactus/util/testsuite"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUpdateUptime(t *testing.T) {
+	ts := testsuite.NewTestSuite(t)
+
+	sb := sandbox.MockingSandbox(ts)
+	sb.TestParams.JailThreshold = 3
+	sb.TestStore.AddTestBlock(1000)
+
+	committers := sb.TestCommittee.Committers()
+	absentee := committers[1]
+	cert := block.NewCertificate(0, committers, []int32{absentee}, nil)
+
+	t.Run("Absent validator should not be jailed before reaching the threshold", func(t *testing.T) {
+		UpdateUptime(cert, sb)
+		UpdateUptime(cert, sb)
+
+		val := sb.ValidatorByNumber(absentee)
+		assert.Equal(t, val.AbsentCount(), uint32(2))
+		assert.False(t, val.IsJailed())
+	})
+
+	t.Run("Absent validator should be jailed on reaching the threshold", func(t *testing.T) {
+		stake := sb.ValidatorByNumber(absentee).Stake()
+		UpdateUptime(cert, sb)
+
+		val := sb.ValidatorByNumber(absentee)
+		assert.Equal(t, val.AbsentCount(), uint32(3))
+		assert.True(t, val.IsJailed())
+		assert.Equal(t, val.JailedHeight(), sb.CurrentHeight())
+		assert.Equal(t, val.Stake(), stake)
+	})
+
+	t.Run("Jailed height should not be updated if the validator is still absent", func(t *testing.T) {
+		jailedHeight := sb.ValidatorByNumber(absentee).JailedHeight()
+		sb.TestStore.AddTestBlock(1001)
+		UpdateUptime(cert, sb)
+
+		val := sb.ValidatorByNumber(absentee)
+		assert.Equal(t, val.AbsentCount(), uint32(4))
+		assert.Equal(t, val.JailedHeight(), jailedHeight)
+	})
+
+	t.Run("Signing the certificate should reset the absent counter", func(t *testing.T) {
+		cert := block.NewCertificate(0, committers, []int32{}, nil)
+		UpdateUptime(cert, sb)
+
+		val := sb.ValidatorByNumber(absentee)
+		assert.Zero(t, val.AbsentCount())
+		assert.True(t, val.IsJailed(), "only unjail transaction can release the validator")
+	})
+
+	t.Run("Present validators should not be jailed", func(t *testing.T) {
+		for _, num := range committers {
+			if num == absentee {
+				continue
+			}
+			val := sb.ValidatorByNumber(num)
+			assert.Zero(t, val.AbsentCount())
+			assert.False(t, val.IsJailed())
+		}
+	})
+}
+
+func TestUpdateUptimeDisabled(t *testing.T) {
+	ts := testsuite.NewTestSuite(t)
+
+	sb := sandbox.MockingSandbox(ts)
+	sb.TestParams.JailThreshold = 0
+
+	committers := sb.TestCommittee.Committers()
+	cert := block.NewCertificate(0, committers, []int32{committers[0]}, nil)
+	UpdateUptime(cert, sb)
+
+	val := sb.ValidatorByNumber(committers[0])
+	assert.Zero(t, val.AbsentCount())
+	assert.False(t, val.IsJailed())
+}
@@ -4,20 +4,24 @@ import (
 	"fmt"
 	"time"
 
+	lp2ppeer "github.com/libp2p/go-libp2p/core/peer"
 	"github.com/multiformats/go-multiaddr"
 	"github.com/pactus-project/pactus/util/errors"
 )
 
 type Config struct {
-	Name          string           `toml:"name"`
-	Listens       []string         `toml:"listens"`
-	NetworkKey    string           `toml:"network_key"`
-	EnableNAT     bool             `toml:"enable_nat"`
-	EnableRelay   bool             `toml:"enable_relay"`
-	RelayAddrs    []string         `toml:"relay_addresses"`
-	EnableMdns    bool             `toml:"enable_mdns"`
-	EnableMetrics bool             `toml:"enable_metrics"`
-	Bootstrap     *BootstrapConfig `toml:"bootstrap"`
+	Name           string           `toml:"name"`
+	Listens        []string         `toml:"listens"`
+	NetworkKey     string           `toml:"network_key"`
+	EnableNAT      bool             `toml:"enable_nat"`
+	EnableRelay    bool             `toml:"enable_relay"`
+	RelayAddrs     []string         `toml:"relay_addresses"`
+	EnableMdns     bool             `toml:"enable_mdns"`
+	EnableMetrics  bool             `toml:"enable_metrics"`
+	AllowedTopics  []string         `toml:"allowed_topics"`
+	ProtectedPeers []string         `toml:"protected_peers"`
+	Bootstrap      *BootstrapConfig `toml:"bootstrap"`
+	Discovery      *DiscoveryConfig `toml:"discovery"`
 }
 
 // BootstrapConfig holds all configuration options related to bootstrap nodes.
@@ -28,6 +32,12 @@ type BootstrapConfig struct {
 	Period       time.Duration `toml:"period"`
 }
 
+// DiscoveryConfig holds all configuration options related to discovering peers via DHT.
+type DiscoveryConfig struct {
+	Rendezvous string        `toml:"rendezvous"`
+	Interval   time.Duration `toml:"interval"`
+}
+
 func DefaultConfig() *Config {
 	nodes := []struct {
 		ip   string
@@ -48,19 +58,25 @@ func DefaultConfig() *Config {
 	}
 
 	return &Config{
-		Name:          "pactus",
-		Listens:       []string{"/ip4/0.0.0.0/tcp/21777", "/ip6/::/tcp/21777"},
-		NetworkKey:    "network_key",
-		EnableNAT:     true,
-		EnableRelay:   false,
-		EnableMdns:    false,
-		EnableMetrics: false,
+		Name:           "pactus",
+		Listens:        []string{"/ip4/0.0.0.0/tcp/21777", "/ip6/::/tcp/21777"},
+		NetworkKey:     "network_key",
+		EnableNAT:      true,
+		EnableRelay:    false,
+		EnableMdns:     false,
+		EnableMetrics:  false,
+		AllowedTopics:  []string{},
+		ProtectedPeers: []string{},
 		Bootstrap: &BootstrapConfig{
 			Addresses:    addresses,
 			MinThreshold: 8,
 			MaxThreshold: 16,
 			Period:       1 * time.Minute,
 		},
+		Discovery: &DiscoveryConfig{
+			Rendezvous: "peers",
+			Interval:   1 * time.Minute,
+		},
 	}
 }
 
@@ -81,6 +97,14 @@ func (conf *Config) SanityCheck() error {
 			return errors.Errorf(errors.ErrInvalidConfig, "at least one relay address should be defined")
 		}
 	}
+	if conf.Discovery != nil && conf.Discovery.Rendezvous != "" && conf.Discovery.Interval <= 0 {
+		return errors.Errorf(errors.ErrInvalidConfig, "discovery interval should be positive")
+	}
+	for _, id := range conf.ProtectedPeers {
+		if _, err := lp2ppeer.Decode(id); err != nil {
+			return errors.Errorf(errors.ErrInvalidConfig, "invalid protected peer: %s", id)
+		}
+	}
 	if err := validateAddresses(conf.RelayAddrs); err != nil {
 		return err
 	}
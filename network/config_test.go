@@ -33,3 +33,29 @@ func TestDefaultConfigCheck(t *testing.T) {
 	conf.Listens = []string{"/ip4/127.0.0.1"}
 	assert.NoError(t, conf.SanityCheck())
 }
+
+func TestDiscoveryConfigCheck(t *testing.T) {
+	conf := DefaultConfig()
+	assert.NoError(t, conf.SanityCheck())
+
+	conf.Discovery.Interval = 0
+	assert.Error(t, conf.SanityCheck())
+
+	// Discovery is disabled
+	conf.Discovery.Rendezvous = ""
+	assert.NoError(t, conf.SanityCheck())
+
+	// No discovery section
+	conf.Discovery = nil
+	assert.NoError(t, conf.SanityCheck())
+}
+
+func TestProtectedPeersConfigCheck(t *testing.T) {
+	conf := DefaultConfig()
+
+	conf.ProtectedPeers = []string{"12D3KooWNYD4bB82YZRXv6oNyYPwc5ozabx2epv75ATV3D8VD3Mq"}
+	assert.NoError(t, conf.SanityCheck())
+
+	conf.ProtectedPeers = []string{"invalid-peer-id"}
+	assert.Error(t, conf.SanityCheck())
+}
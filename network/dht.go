@@ -6,6 +6,7 @@ import (
 	lp2pdht "github.com/libp2p/go-libp2p-kad-dht"
 	lp2pcore "github.com/libp2p/go-libp2p/core"
 	lp2phost "github.com/libp2p/go-libp2p/core/host"
+	lp2pdrouting "github.com/libp2p/go-libp2p/p2p/discovery/routing"
 	"github.com/pactus-project/pactus/util/logger"
 )
 
@@ -14,11 +15,13 @@ type dhtService struct {
 	host      lp2phost.Host
 	kademlia  *lp2pdht.IpfsDHT
 	bootstrap *bootstrap
+	discovery *discovery
 	logger    *logger.Logger
 }
 
 func newDHTService(ctx context.Context, host lp2phost.Host, protocolID lp2pcore.ProtocolID,
-	conf *BootstrapConfig, logger *logger.Logger) *dhtService {
+	rendezvous string, bootstrapConf *BootstrapConfig, discoveryConf *DiscoveryConfig,
+	logger *logger.Logger) *dhtService {
 	opts := []lp2pdht.Option{
 		lp2pdht.Mode(lp2pdht.ModeAuto),
 		lp2pdht.ProtocolPrefix(protocolID),
@@ -32,23 +35,38 @@ func newDHTService(ctx context.Context, host lp2phost.Host, protocolID lp2pcore.
 
 	bootstrap := newBootstrap(ctx,
 		host, host.Network(), kademlia,
-		conf, logger)
+		bootstrapConf, logger)
+
+	// Discovery is disabled if no rendezvous is set.
+	var discovery *discovery
+	if rendezvous != "" {
+		discovery = newDiscovery(ctx, host, lp2pdrouting.NewRoutingDiscovery(kademlia),
+			rendezvous, discoveryConf, logger)
+	}
 
 	return &dhtService{
 		ctx:       ctx,
 		host:      host,
 		kademlia:  kademlia,
 		bootstrap: bootstrap,
+		discovery: discovery,
 		logger:    logger,
 	}
 }
 
 func (dht *dhtService) Start() error {
 	dht.bootstrap.Start()
+	if dht.discovery != nil {
+		dht.discovery.Start()
+	}
 	return nil
 }
 
 func (dht *dhtService) Stop() {
+	if dht.discovery != nil {
+		dht.discovery.Stop()
+	}
+
 	if err := dht.kademlia.Close(); err != nil {
 		dht.logger.Error("unable to close Kademlia", "err", err)
 	}
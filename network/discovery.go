@@ -0,0 +1,118 @@
+package network
+
+import (
+	"context"
+	"time"
+
+	lp2pdiscovery "github.com/libp2p/go-libp2p/core/discovery"
+	lp2phost "github.com/libp2p/go-libp2p/core/host"
+	lp2pnet "github.com/libp2p/go-libp2p/core/network"
+	"github.com/pactus-project/pactus/util/logger"
+)
+
+// discovery periodically advertises the host under a rendezvous string via the DHT
+// and connects to the peers that are advertised under the same rendezvous.
+// To stop the discovery cancel the context passed in newDiscovery() or call Stop().
+type discovery struct {
+	ctx        context.Context
+	cancel     context.CancelFunc
+	config     *DiscoveryConfig
+	rendezvous string
+
+	// Dependencies
+	host      lp2phost.Host
+	discovery lp2pdiscovery.Discovery
+
+	nextAdvertise time.Time
+	logger        *logger.Logger
+}
+
+// newDiscovery returns a new discovery that advertises and discovers peers
+// under the given rendezvous using the given discovery service.
+func newDiscovery(ctx context.Context, h lp2phost.Host, d lp2pdiscovery.Discovery,
+	rendezvous string, conf *DiscoveryConfig, logger *logger.Logger) *discovery {
+	ctx, cancel := context.WithCancel(ctx)
+
+	return &discovery{
+		ctx:        ctx,
+		cancel:     cancel,
+		config:     conf,
+		rendezvous: rendezvous,
+		host:       h,
+		discovery:  d,
+		logger:     logger,
+	}
+}
+
+// Start starts the discovery loop. Cancel `ctx` or call Stop() to stop it.
+// The first cycle runs immediately, so the node doesn't wait one interval
+// before finding its peers.
+func (d *discovery) Start() {
+	go func() {
+		d.advertise()
+		d.discoverPeers()
+
+		ticker := time.NewTicker(d.config.Interval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-d.ctx.Done():
+				return
+			case <-ticker.C:
+				d.advertise()
+				d.discoverPeers()
+			}
+		}
+	}()
+}
+
+// Stop stops the discovery loop.
+func (d *discovery) Stop() {
+	d.cancel()
+}
+
+// advertise announces the host under the rendezvous string.
+// The advertisement is renewed before its time-to-live expires.
+func (d *discovery) advertise() {
+	if time.Now().Before(d.nextAdvertise) {
+		return
+	}
+
+	ttl, err := d.discovery.Advertise(d.ctx, d.rendezvous)
+	if err != nil {
+		d.logger.Debug("unable to advertise", "rendezvous", d.rendezvous, "err", err)
+		return
+	}
+
+	d.logger.Debug("advertised", "rendezvous", d.rendezvous, "ttl", ttl)
+	d.nextAdvertise = time.Now().Add(7 * ttl / 8)
+}
+
+// discoverPeers finds the peers advertised under the rendezvous string
+// and connects to those that are not connected yet.
+func (d *discovery) discoverPeers() {
+	ctx, cancel := context.WithTimeout(d.ctx, d.config.Interval)
+	defer cancel()
+
+	peerCh, err := d.discovery.FindPeers(ctx, d.rendezvous)
+	if err != nil {
+		d.logger.Debug("unable to find peers", "rendezvous", d.rendezvous, "err", err)
+		return
+	}
+
+	for pi := range peerCh {
+		if pi.ID == d.host.ID() || len(pi.Addrs) == 0 {
+			continue
+		}
+
+		if d.host.Network().Connectedness(pi.ID) == lp2pnet.Connected {
+			continue
+		}
+
+		d.logger.Debug("connecting to a discovered peer", "id", pi.ID.Pretty())
+		if err := d.host.Connect(ctx, pi); err != nil {
+			d.logger.Debug("unable to connect to a discovered peer", "id", pi.ID.Pretty(), "err", err)
+		}
+	}
+}
@@ -0,0 +1,143 @@
+package network
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	lp2p "github.com/libp2p/go-libp2p"
+	lp2pnet "github.com/libp2p/go-libp2p/core/network"
+	lp2ppeer "github.com/libp2p/go-libp2p/core/peer"
+	lp2pdmocks "github.com/libp2p/go-libp2p/p2p/discovery/mocks"
+	lp2pdutil "github.com/libp2p/go-libp2p/p2p/discovery/util"
+	"github.com/pactus-project/pactus/util/logger"
+	"github.com/pactus-project/pactus/util/testsuite"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// frozenClock keeps the advertisements in the mock discovery server alive.
+// The discovery advertises without a TTL, which expires at once with a running clock.
+type frozenClock struct {
+	now time.Time
+}
+
+func (c frozenClock) Now() time.Time { return c.now }
+
+// In this test, two hosts, M and N, use the same rendezvous and start their
+// discovery loops. The hosts are not connected to each other or to any other node,
+// so only the discovery loop can connect them. They should be connected
+// over one discovery cycle.
+func TestDiscovery(t *testing.T) {
+	interval := 2 * time.Second
+	conf := &DiscoveryConfig{
+		Rendezvous: "test-rendezvous",
+		Interval:   interval,
+	}
+	server := lp2pdmocks.NewDiscoveryServer(frozenClock{now: time.Now()})
+
+	makeDiscovery := func() *discovery {
+		h, err := lp2p.New(lp2p.ListenAddrStrings("/ip4/127.0.0.1/tcp/0"))
+		require.NoError(t, err)
+		t.Cleanup(func() { _ = h.Close() })
+
+		return newDiscovery(context.Background(), h, lp2pdmocks.NewDiscoveryClient(h, server),
+			"/test-network/rendezvous/test-rendezvous/v1", conf, logger.NewLogger("_network", nil))
+	}
+
+	discoveryM := makeDiscovery()
+	discoveryN := makeDiscovery()
+	hostM := discoveryM.host
+	hostN := discoveryN.host
+	assert.NotEqual(t, lp2pnet.Connected, hostM.Network().Connectedness(hostN.ID()))
+
+	discoveryM.Start()
+	discoveryN.Start()
+
+	require.Eventually(t, func() bool {
+		return hostM.Network().Connectedness(hostN.ID()) == lp2pnet.Connected &&
+			hostN.Network().Connectedness(hostM.ID()) == lp2pnet.Connected
+	}, interval, 100*time.Millisecond)
+
+	discoveryM.Stop()
+	discoveryN.Stop()
+}
+
+// In this test, two nodes, M and N, are connected to the bootstrap node B.
+// They should advertise themselves under the rendezvous through the DHT.
+func TestDiscoveryNetwork(t *testing.T) {
+	ts := testsuite.NewTestSuite(t)
+
+	// Bootstrap node
+	confB := testConfig()
+	bootstrapPort := ts.RandInt32(9999) + 10000
+	confB.Listens = []string{
+		fmt.Sprintf("/ip4/127.0.0.1/tcp/%v", bootstrapPort),
+	}
+	networkB := makeTestNetwork(t, confB, []lp2p.Option{
+		lp2p.ForceReachabilityPublic(),
+	})
+	bootstrapAddresses := []string{
+		fmt.Sprintf("/ip4/127.0.0.1/tcp/%v/p2p/%v", bootstrapPort, networkB.SelfID().String()),
+	}
+
+	makeDiscoveryNetwork := func() *network {
+		conf := testConfig()
+		conf.Listens = []string{"/ip4/127.0.0.1/tcp/0"}
+		conf.Bootstrap.Addresses = bootstrapAddresses
+		conf.Bootstrap.Period = time.Minute
+		conf.Discovery.Rendezvous = "test-rendezvous"
+		conf.Discovery.Interval = 1 * time.Second
+
+		return makeTestNetwork(t, conf, []lp2p.Option{
+			lp2p.ForceReachabilityPublic(),
+		})
+	}
+
+	networkM := makeDiscoveryNetwork()
+	networkN := makeDiscoveryNetwork()
+
+	findPeers := func(net *network) []lp2ppeer.ID {
+		ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+		defer cancel()
+
+		peers, err := lp2pdutil.FindPeers(ctx, net.dht.discovery.discovery, net.dht.discovery.rendezvous)
+		assert.NoError(t, err)
+
+		ids := make([]lp2ppeer.ID, 0, len(peers))
+		for _, pi := range peers {
+			ids = append(ids, pi.ID)
+		}
+		return ids
+	}
+
+	t.Run("Rendezvous should be namespaced by the network name", func(t *testing.T) {
+		assert.Equal(t, "/test-network/rendezvous/test-rendezvous/v1", networkM.dht.discovery.rendezvous)
+	})
+
+	t.Run("Nodes should advertise themselves", func(t *testing.T) {
+		require.Eventually(t, func() bool {
+			return hasPID(findPeers(networkM), networkN.SelfID()) &&
+				hasPID(findPeers(networkN), networkM.SelfID())
+		}, 5*time.Second, 250*time.Millisecond)
+	})
+
+	t.Run("Bootstrap node has no discovery", func(t *testing.T) {
+		assert.Nil(t, networkB.dht.discovery)
+	})
+
+	t.Run("Node without discovery config has no discovery", func(t *testing.T) {
+		conf := testConfig()
+		conf.Discovery = nil
+		net, err := newNetwork(conf, nil)
+		require.NoError(t, err)
+
+		assert.Nil(t, net.dht.discovery)
+		net.Stop()
+	})
+
+	networkM.Stop()
+	networkN.Stop()
+	networkB.Stop()
+}
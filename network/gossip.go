@@ -21,9 +21,17 @@ type gossipService struct {
 	logger  *logger.Logger
 }
 
-func newGossipService(ctx context.Context, host lp2phost.Host, eventCh chan Event,
-	logger *logger.Logger) *gossipService {
-	pubsub, err := lp2pps.NewGossipSub(ctx, host)
+// newGossipService creates a new gossip service.
+// If allowedTopics is not empty, joining or publishing to other topics is rejected.
+func newGossipService(ctx context.Context, host lp2phost.Host, allowedTopics []string,
+	eventCh chan Event, logger *logger.Logger) *gossipService {
+	opts := []lp2pps.Option{}
+	if len(allowedTopics) > 0 {
+		filter := lp2pps.NewAllowlistSubscriptionFilter(allowedTopics...)
+		opts = append(opts, lp2pps.WithSubscriptionFilter(filter))
+	}
+
+	pubsub, err := lp2pps.NewGossipSub(ctx, host, opts...)
 	if err != nil {
 		logger.Panic("unable to start Gossip service", "err", err)
 		return nil
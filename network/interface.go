@@ -77,6 +77,8 @@ type Network interface {
 	JoinGeneralTopic() error
 	JoinConsensusTopic() error
 	CloseConnection(pid lp2pcore.PeerID)
+	Protect(pid lp2pcore.PeerID, tag string)
+	Unprotect(pid lp2pcore.PeerID, tag string) bool
 	SelfID() lp2pcore.PeerID
 	NumConnectedPeers() int
 }
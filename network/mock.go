@@ -98,6 +98,11 @@ func (mock *MockNetwork) CloseConnection(pid peer.ID) {
 		}
 	}
 }
+func (mock *MockNetwork) Protect(_ peer.ID, _ string) {
+}
+func (mock *MockNetwork) Unprotect(_ peer.ID, _ string) bool {
+	return false
+}
 func (mock *MockNetwork) IsClosed(pid peer.ID) bool {
 	for _, net := range mock.OtherNets {
 		if net.ID == pid {
@@ -161,6 +161,14 @@ func newNetwork(conf *Config, opts []lp2p.Option) (*network, error) {
 
 	n.logger = logger.NewLogger("_network", n)
 
+	for _, id := range conf.ProtectedPeers {
+		pid, err := lp2ppeer.Decode(id)
+		if err != nil {
+			return nil, errors.Errorf(errors.ErrNetwork, err.Error())
+		}
+		n.Protect(pid, "config")
+	}
+
 	if conf.EnableMdns {
 		n.mdns = newMdnsService(ctx, n.host, n.logger)
 	}
@@ -168,9 +176,22 @@ func newNetwork(conf *Config, opts []lp2p.Option) (*network, error) {
 	kadProtocolID := lp2pcore.ProtocolID(fmt.Sprintf("/%s/kad/v1", n.config.Name))
 	streamProtocolID := lp2pcore.ProtocolID(fmt.Sprintf("/%s/stream/v1", n.config.Name))
 
-	n.dht = newDHTService(n.ctx, n.host, kadProtocolID, conf.Bootstrap, n.logger)
+	// The rendezvous is namespaced by the network name, so nodes of different
+	// networks never discover each other.
+	// Discovery is disabled for the old configs that have no discovery section.
+	rendezvous := ""
+	if conf.Discovery != nil && conf.Discovery.Rendezvous != "" {
+		rendezvous = fmt.Sprintf("/%s/rendezvous/%s/v1", n.config.Name, conf.Discovery.Rendezvous)
+	}
+
+	n.dht = newDHTService(n.ctx, n.host, kadProtocolID, rendezvous, conf.Bootstrap, conf.Discovery, n.logger)
 	n.stream = newStreamService(ctx, n.host, streamProtocolID, relayAddrs, n.eventChannel, n.logger)
-	n.gossip = newGossipService(ctx, n.host, n.eventChannel, n.logger)
+	allowedTopics := make([]string, 0, len(conf.AllowedTopics))
+	for _, t := range conf.AllowedTopics {
+		allowedTopics = append(allowedTopics, n.TopicName(t))
+	}
+
+	n.gossip = newGossipService(ctx, n.host, allowedTopics, n.eventChannel, n.logger)
 
 	n.logger.Info("network setup", "id", n.host.ID(), "address", conf.Listens)
 
@@ -291,6 +312,19 @@ func (n *network) CloseConnection(pid lp2ppeer.ID) {
 	}
 }
 
+// Protect protects the peer from being trimmed by the connection manager.
+// A peer can be protected with different tags, and it remains protected
+// until all its tags are removed.
+func (n *network) Protect(pid lp2ppeer.ID, tag string) {
+	n.host.ConnManager().Protect(pid, tag)
+}
+
+// Unprotect removes the protection tag from the peer.
+// It returns true if the peer is still protected by other tags.
+func (n *network) Unprotect(pid lp2ppeer.ID, tag string) bool {
+	return n.host.ConnManager().Unprotect(pid, tag)
+}
+
 func (n *network) Fingerprint() string {
 	return fmt.Sprintf("{%d}", n.NumConnectedPeers())
 }
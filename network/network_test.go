@@ -1,6 +1,7 @@
 package network
 
 import (
+	"context"
 	"fmt"
 	"io"
 	"testing"
@@ -8,8 +9,12 @@ import (
 
 	lp2p "github.com/libp2p/go-libp2p"
 	"github.com/libp2p/go-libp2p/core/host"
+	lp2pnet "github.com/libp2p/go-libp2p/core/network"
+	lp2ppeer "github.com/libp2p/go-libp2p/core/peer"
+	lp2pconnmgr "github.com/libp2p/go-libp2p/p2p/net/connmgr"
 	ma "github.com/multiformats/go-multiaddr"
 	"github.com/pactus-project/pactus/util"
+	"github.com/pactus-project/pactus/util/errors"
 	"github.com/pactus-project/pactus/util/testsuite"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -63,6 +68,10 @@ func testConfig() *Config {
 			MaxThreshold: 8,
 			Period:       2 * time.Second,
 		},
+		Discovery: &DiscoveryConfig{
+			Rendezvous: "",
+			Interval:   1 * time.Second,
+		},
 	}
 }
 
@@ -114,6 +123,37 @@ func TestStoppingNetwork(t *testing.T) {
 	net.Stop()
 }
 
+func TestAllowedTopics(t *testing.T) {
+	t.Run("Empty allow-list, all topics are allowed", func(t *testing.T) {
+		conf := testConfig()
+		net, err := newNetwork(conf, nil)
+		assert.NoError(t, err)
+		assert.NoError(t, net.Start())
+
+		assert.NoError(t, net.JoinGeneralTopic())
+		assert.NoError(t, net.JoinConsensusTopic())
+
+		net.Stop()
+	})
+
+	t.Run("Non-listed topics are rejected", func(t *testing.T) {
+		conf := testConfig()
+		conf.AllowedTopics = []string{"general"}
+		net, err := newNetwork(conf, nil)
+		assert.NoError(t, err)
+		assert.NoError(t, net.Start())
+
+		assert.NoError(t, net.JoinGeneralTopic())
+		assert.NoError(t, net.Broadcast([]byte("test-general-topic"), TopicIDGeneral))
+
+		err = net.JoinConsensusTopic()
+		assert.Equal(t, errors.Code(err), errors.ErrNetwork)
+		assert.Error(t, net.Broadcast([]byte("test-consensus-topic"), TopicIDConsensus))
+
+		net.Stop()
+	})
+}
+
 // In this test, we are setting up a simulated network environment that consists of six nodes:
 //   - R is a Relay node
 //   - B is a Bootstrap node
@@ -321,3 +361,79 @@ func TestInvalidRelayAddress(t *testing.T) {
 	_, err = NewNetwork(conf)
 	assert.Error(t, err)
 }
+
+// In this test, five peers connect to node A, which has a low-water mark of one
+// and a high-water mark of two connections. Two of them are protected.
+// Only unprotected peers should be trimmed by the connection manager.
+// Note that the connection manager keeps low-water unprotected connections.
+func TestProtectedPeers(t *testing.T) {
+	peers := make([]host.Host, 6)
+	for i := range peers {
+		h, err := lp2p.New(lp2p.ListenAddrStrings("/ip4/127.0.0.1/tcp/0"))
+		require.NoError(t, err)
+		peers[i] = h
+		defer h.Close()
+	}
+
+	connMgr, err := lp2pconnmgr.NewConnManager(1, 2, lp2pconnmgr.WithGracePeriod(0))
+	require.NoError(t, err)
+
+	confA := testConfig()
+	confA.Listens = []string{"/ip4/127.0.0.1/tcp/0"}
+	confA.ProtectedPeers = []string{peers[1].ID().String()}
+	confA.Bootstrap.Addresses = []string{
+		fmt.Sprintf("%s/p2p/%s", peers[2].Addrs()[0], peers[2].ID()),
+	}
+	networkA := makeTestNetwork(t, confA, []lp2p.Option{
+		lp2p.ConnectionManager(connMgr),
+	})
+	defer networkA.Stop()
+
+	networkA.Protect(peers[0].ID(), "test")
+
+	for _, p := range peers {
+		require.NoError(t, p.Connect(context.Background(), lp2ppeer.AddrInfo{
+			ID:    networkA.SelfID(),
+			Addrs: networkA.host.Addrs(),
+		}))
+	}
+	require.Eventually(t, func() bool {
+		return networkA.NumConnectedPeers() == len(peers)
+	}, 5*time.Second, 10*time.Millisecond)
+
+	isConnected := func(h host.Host) bool {
+		return networkA.host.Network().Connectedness(h.ID()) == lp2pnet.Connected
+	}
+	shouldTrimTo := func(unprotected []host.Host, count int) {
+		assert.Eventually(t, func() bool {
+			networkA.host.ConnManager().TrimOpenConns(context.Background())
+
+			connected := 0
+			for _, h := range unprotected {
+				if isConnected(h) {
+					connected++
+				}
+			}
+			return connected == count
+		}, 5*time.Second, 50*time.Millisecond)
+	}
+
+	shouldTrimTo(peers[3:], 1)
+	assert.True(t, isConnected(peers[0]), "protected by API")
+	assert.True(t, isConnected(peers[1]), "protected by config")
+	assert.True(t, isConnected(peers[2]), "protected as bootstrap peer")
+	assert.True(t, networkA.host.ConnManager().IsProtected(peers[2].ID(), "bootstrap"))
+
+	assert.False(t, networkA.Unprotect(peers[0].ID(), "test"))
+
+	// A configured peer remains protected until its config tag is removed.
+	networkA.Protect(peers[1].ID(), "test")
+	assert.True(t, networkA.Unprotect(peers[1].ID(), "test"), "still protected by config")
+	assert.False(t, networkA.Unprotect(peers[1].ID(), "config"))
+
+	// Removing other tags doesn't affect the bootstrap protection.
+	assert.True(t, networkA.Unprotect(peers[2].ID(), "test"), "still protected as bootstrap peer")
+
+	shouldTrimTo(append([]host.Host{peers[0], peers[1]}, peers[3:]...), 1)
+	assert.True(t, isConnected(peers[2]), "protected as bootstrap peer")
+}
@@ -63,7 +63,7 @@ func NewNode(genDoc *genesis.Genesis, conf *config.Config,
 		return nil, err
 	}
 
-	state, err := state.LoadOrNewState(genDoc, signers, store, txPool, eventCh)
+	state, err := state.LoadOrNewState(conf.State, genDoc, signers, store, txPool, eventCh)
 	if err != nil {
 		return nil, err
 	}
@@ -111,6 +111,8 @@ func (n *Node) Start() error {
 	// Wait for network to started
 	time.Sleep(1 * time.Second)
 
+	n.txPool.Start()
+
 	if err := n.consMgr.Start(); err != nil {
 		return err
 	}
@@ -141,6 +143,7 @@ func (n *Node) Stop() {
 	logger.Info("stopping Node")
 
 	n.consMgr.Stop()
+	n.txPool.Stop()
 	n.network.Stop()
 	n.sync.Stop()
 	n.state.Close()
@@ -18,6 +18,8 @@ type Sandbox interface {
 	UpdateAccount(crypto.Address, *account.Account)
 
 	Validator(crypto.Address) *validator.Validator
+	ValidatorByNumber(int32) *validator.Validator
+	TotalValidators() int32
 	MakeNewValidator(*bls.PublicKey) *validator.Validator
 	UpdateValidator(*validator.Validator)
 	UpdatePowerDelta(delta int64)
@@ -32,4 +34,6 @@ type Sandbox interface {
 
 	IterateAccounts(consumer func(addr crypto.Address, acc *account.Account, updated bool))
 	IterateValidators(consumer func(val *validator.Validator, updated bool))
+
+	VerifyConsistency() error
 }
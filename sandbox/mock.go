@@ -11,6 +11,7 @@ import (
 	"github.com/pactus-project/pactus/types/block"
 	"github.com/pactus-project/pactus/types/param"
 	"github.com/pactus-project/pactus/types/validator"
+	"github.com/pactus-project/pactus/util/errors"
 	"github.com/pactus-project/pactus/util/testsuite"
 )
 
@@ -26,6 +27,9 @@ type MockSandbox struct {
 	TestCommitteeSigners []crypto.Signer
 	TestAcceptSortition  bool
 	TestPowerDelta       int64
+
+	// netStake is the net change of the bonded stakes since the mock is created.
+	netStake int64
 }
 
 func MockingSandbox(ts *testsuite.TestSuite) *MockSandbox {
@@ -53,6 +57,7 @@ func MockingSandbox(ts *testsuite.TestSuite) *MockSandbox {
 	acc0 := account.NewAccount(0)
 	acc0.AddToBalance(treasuryAmt)
 	sb.UpdateAccount(crypto.TreasuryAddress, acc0)
+	sb.netStake = 0
 
 	return sb
 }
@@ -71,10 +76,21 @@ func (m *MockSandbox) Validator(addr crypto.Address) *validator.Validator {
 	val, _ := m.TestStore.Validator(addr)
 	return val
 }
+func (m *MockSandbox) ValidatorByNumber(num int32) *validator.Validator {
+	val, _ := m.TestStore.ValidatorByNumber(num)
+	return val
+}
+func (m *MockSandbox) TotalValidators() int32 {
+	return m.TestStore.TotalValidators()
+}
 func (m *MockSandbox) MakeNewValidator(pub *bls.PublicKey) *validator.Validator {
 	return validator.NewValidator(pub, m.TestStore.TotalValidators())
 }
 func (m *MockSandbox) UpdateValidator(val *validator.Validator) {
+	m.netStake += bondedStake(val)
+	if old, err := m.TestStore.Validator(val.Address()); err == nil {
+		m.netStake -= bondedStake(old)
+	}
 	m.TestStore.UpdateValidator(val)
 }
 func (m *MockSandbox) CurrentHeight() uint32 {
@@ -111,3 +127,33 @@ func (m *MockSandbox) PowerDelta() int64 {
 func (m *MockSandbox) VerifyProof(hash.Stamp, sortition.Proof, *validator.Validator) bool {
 	return m.TestAcceptSortition
 }
+
+// VerifyConsistency checks the stake of the validators, the power delta and the committee size.
+// Since the mock sandbox updates the store directly, the net change of the bonded stakes
+// is tracked by UpdateValidator.
+func (m *MockSandbox) VerifyConsistency() error {
+	var err error
+	m.TestStore.IterateValidators(func(val *validator.Validator) bool {
+		if val.Stake() < 0 || val.Stake() > m.TestParams.MaximumStake {
+			err = errors.Errorf(errors.ErrInvalidAmount,
+				"stake of validator %v is out of range: %v", val.Address(), val.Stake())
+			return true
+		}
+		return false
+	})
+	if err != nil {
+		return err
+	}
+
+	if m.netStake != m.TestPowerDelta {
+		return errors.Errorf(errors.ErrGeneric,
+			"power delta is not consistent, expected: %v, got: %v", m.netStake, m.TestPowerDelta)
+	}
+
+	if m.TestCommittee.Size() > m.TestParams.CommitteeSize {
+		return errors.Errorf(errors.ErrGeneric,
+			"committee size exceeded, maximum: %v, got: %v", m.TestParams.CommitteeSize, m.TestCommittee.Size())
+	}
+
+	return nil
+}
@@ -13,6 +13,7 @@ import (
 	"github.com/pactus-project/pactus/types/block"
 	"github.com/pactus-project/pactus/types/param"
 	"github.com/pactus-project/pactus/types/validator"
+	"github.com/pactus-project/pactus/util/errors"
 	"github.com/pactus-project/pactus/util/logger"
 )
 
@@ -149,6 +150,36 @@ func (sb *sandbox) Validator(addr crypto.Address) *validator.Validator {
 	return val.Clone()
 }
 
+// ValidatorByNumber returns the validator with the given number.
+// If the validator is already inside the sandbox, the sandboxed copy is returned.
+func (sb *sandbox) ValidatorByNumber(num int32) *validator.Validator {
+	sb.lk.Lock()
+	defer sb.lk.Unlock()
+
+	val, err := sb.store.ValidatorByNumber(num)
+	if err != nil {
+		return nil
+	}
+
+	s, ok := sb.validators[val.Address()]
+	if ok {
+		return s.validator.Clone()
+	}
+	sb.validators[val.Address()] = &sandboxValidator{
+		validator: val,
+	}
+	return val.Clone()
+}
+
+// TotalValidators returns the total number of validators, including the ones
+// created inside the sandbox.
+func (sb *sandbox) TotalValidators() int32 {
+	sb.lk.RLock()
+	defer sb.lk.RUnlock()
+
+	return sb.totalValidators
+}
+
 func (sb *sandbox) MakeNewValidator(pub *bls.PublicKey) *validator.Validator {
 	sb.lk.Lock()
 	defer sb.lk.Unlock()
@@ -243,6 +274,54 @@ func (sb *sandbox) PowerDelta() int64 {
 	return sb.powerDelta
 }
 
+// VerifyConsistency verifies the internal consistency of the sandbox.
+// It checks that the stake of the validators is within the valid range,
+// the power delta matches the changes in the bonded stakes, and
+// the committee size doesn't exceed the maximum committee size.
+func (sb *sandbox) VerifyConsistency() error {
+	sb.lk.RLock()
+	defer sb.lk.RUnlock()
+
+	netStake := int64(0)
+	for addr, sv := range sb.validators {
+		val := sv.validator
+		if val.Stake() < 0 || val.Stake() > sb.params.MaximumStake {
+			return errors.Errorf(errors.ErrInvalidAmount,
+				"stake of validator %v is out of range: %v", addr, val.Stake())
+		}
+
+		if !sv.updated {
+			continue
+		}
+
+		netStake += bondedStake(val)
+		old, err := sb.store.Validator(addr)
+		if err == nil {
+			netStake -= bondedStake(old)
+		}
+	}
+
+	if netStake != sb.powerDelta {
+		return errors.Errorf(errors.ErrGeneric,
+			"power delta is not consistent, expected: %v, got: %v", netStake, sb.powerDelta)
+	}
+
+	if sb.committee.Size() > sb.params.CommitteeSize {
+		return errors.Errorf(errors.ErrGeneric,
+			"committee size exceeded, maximum: %v, got: %v", sb.params.CommitteeSize, sb.committee.Size())
+	}
+
+	return nil
+}
+
+// bondedStake returns the stake of the validator if it is not unbonded, otherwise zero.
+func bondedStake(val *validator.Validator) int64 {
+	if val.UnbondingHeight() > 0 {
+		return 0
+	}
+	return val.Stake()
+}
+
 // VerifyProof verifies proof of a sortition transaction.
 func (sb *sandbox) VerifyProof(stamp hash.Stamp, proof sortition.Proof, val *validator.Validator) bool {
 	_, b := sb.store.RecentBlockByStamp(stamp)
@@ -250,5 +329,6 @@ func (sb *sandbox) VerifyProof(stamp hash.Stamp, proof sortition.Proof, val *val
 		return false
 	}
 	seed := b.Header().SortitionSeed()
-	return sortition.VerifyProof(seed, proof, val.PublicKey(), sb.totalPower, val.Power())
+	power := val.EffectivePower(sb.CurrentHeight(), sb.params.StakeActivationInterval)
+	return sortition.VerifyProof(seed, proof, val.PublicKey(), sb.totalPower, power)
 }
@@ -11,6 +11,7 @@ import (
 	"github.com/pactus-project/pactus/types/account"
 	"github.com/pactus-project/pactus/types/param"
 	"github.com/pactus-project/pactus/types/validator"
+	"github.com/pactus-project/pactus/util/errors"
 	"github.com/pactus-project/pactus/util/testsuite"
 	"github.com/stretchr/testify/assert"
 )
@@ -221,6 +222,27 @@ func TestValidatorChange(t *testing.T) {
 	})
 }
 
+func TestValidatorByNumber(t *testing.T) {
+	td := setup(t)
+
+	t.Run("Should returns nil for invalid number", func(t *testing.T) {
+		assert.Nil(t, td.sandbox.ValidatorByNumber(td.RandInt32(1000)+21))
+	})
+
+	t.Run("Should return the sandboxed validator", func(t *testing.T) {
+		val := td.sandbox.Committee().Validators()[0]
+		sbVal1 := td.sandbox.ValidatorByNumber(val.Number())
+		assert.Equal(t, val.Hash(), sbVal1.Hash())
+
+		sbVal1.IncSequence()
+		td.sandbox.UpdateValidator(sbVal1)
+
+		sbVal2 := td.sandbox.ValidatorByNumber(val.Number())
+		assert.Equal(t, sbVal2.Sequence(), val.Sequence()+1)
+		assert.True(t, td.sandbox.validators[val.Address()].updated)
+	})
+}
+
 func TestTotalAccountCounter(t *testing.T) {
 	td := setup(t)
 
@@ -245,9 +267,11 @@ func TestTotalValidatorCounter(t *testing.T) {
 
 		pub, _ := td.RandomBLSKeyPair()
 		pub2, _ := td.RandomBLSKeyPair()
+		assert.Equal(t, td.sandbox.TotalValidators(), int32(td.sandbox.Committee().Size()))
 		val1 := td.sandbox.MakeNewValidator(pub)
 		val1.UpdateLastBondingHeight(td.sandbox.CurrentHeight())
 		assert.Equal(t, val1.Number(), int32(td.sandbox.Committee().Size()))
+		assert.Equal(t, td.sandbox.TotalValidators(), int32(td.sandbox.Committee().Size()+1))
 		assert.Equal(t, val1.LastBondingHeight(), td.sandbox.CurrentHeight())
 
 		val2 := td.sandbox.MakeNewValidator(pub2)
@@ -255,6 +279,8 @@ func TestTotalValidatorCounter(t *testing.T) {
 		assert.Equal(t, val2.Number(), int32(td.sandbox.Committee().Size()+1))
 		assert.Equal(t, val2.LastBondingHeight(), td.sandbox.CurrentHeight()+1)
 		assert.Equal(t, val2.Stake(), int64(0))
+		assert.Equal(t, td.sandbox.TotalValidators(), int32(td.sandbox.Committee().Size()+2))
+		assert.Equal(t, td.store.TotalValidators(), int32(td.sandbox.Committee().Size()))
 	})
 }
 
@@ -388,6 +414,71 @@ func TestPowerDelta(t *testing.T) {
 	assert.Zero(t, td.sandbox.PowerDelta())
 }
 
+func TestVerifyConsistency(t *testing.T) {
+	t.Run("Consistent sandbox", func(t *testing.T) {
+		td := setup(t)
+
+		pub, _ := td.RandomBLSKeyPair()
+		val1 := td.sandbox.MakeNewValidator(pub)
+		val1.AddToStake(1000)
+		td.sandbox.UpdateValidator(val1)
+		td.sandbox.UpdatePowerDelta(1000)
+
+		val2 := td.sandbox.Validator(td.sandbox.committee.Validators()[0].Address())
+		val2.UpdateUnbondingHeight(td.sandbox.CurrentHeight())
+		td.sandbox.UpdateValidator(val2)
+		td.sandbox.UpdatePowerDelta(-1 * val2.Stake())
+
+		assert.NoError(t, td.sandbox.VerifyConsistency())
+	})
+
+	t.Run("Power delta mismatch", func(t *testing.T) {
+		td := setup(t)
+
+		pub, _ := td.RandomBLSKeyPair()
+		val := td.sandbox.MakeNewValidator(pub)
+		val.AddToStake(1000)
+		td.sandbox.UpdateValidator(val)
+		td.sandbox.UpdatePowerDelta(999)
+
+		assert.Error(t, td.sandbox.VerifyConsistency())
+	})
+
+	t.Run("Negative stake", func(t *testing.T) {
+		td := setup(t)
+
+		pub, _ := td.RandomBLSKeyPair()
+		val := td.sandbox.MakeNewValidator(pub)
+		val.SubtractFromStake(1)
+		td.sandbox.UpdateValidator(val)
+		td.sandbox.UpdatePowerDelta(-1)
+
+		err := td.sandbox.VerifyConsistency()
+		assert.Equal(t, errors.Code(err), errors.ErrInvalidAmount)
+	})
+
+	t.Run("Stake exceeds the maximum stake", func(t *testing.T) {
+		td := setup(t)
+
+		pub, _ := td.RandomBLSKeyPair()
+		val := td.sandbox.MakeNewValidator(pub)
+		val.AddToStake(td.sandbox.params.MaximumStake + 1)
+		td.sandbox.UpdateValidator(val)
+		td.sandbox.UpdatePowerDelta(td.sandbox.params.MaximumStake + 1)
+
+		err := td.sandbox.VerifyConsistency()
+		assert.Equal(t, errors.Code(err), errors.ErrInvalidAmount)
+	})
+
+	t.Run("Committee size exceeded", func(t *testing.T) {
+		td := setup(t)
+
+		td.sandbox.params.CommitteeSize = td.sandbox.committee.Size() - 1
+
+		assert.Error(t, td.sandbox.VerifyConsistency())
+	})
+}
+
 func TestVerifyProof(t *testing.T) {
 	td := setup(t)
 
@@ -430,4 +521,16 @@ func TestVerifyProof(t *testing.T) {
 	t.Run("Ok", func(t *testing.T) {
 		assert.True(t, td.sandbox.VerifyProof(validStamp, validProof, validVal))
 	})
+
+	t.Run("stake is not activated yet", func(t *testing.T) {
+		td.sandbox.params.StakeActivationInterval = 100
+		defer func() { td.sandbox.params.StakeActivationInterval = 0 }()
+
+		activatingVal := validVal.Clone()
+		activatingVal.UpdateActivatingStake(activatingVal.Stake())
+		activatingVal.UpdateLastBondingHeight(td.sandbox.CurrentHeight())
+
+		assert.Zero(t, activatingVal.EffectivePower(td.sandbox.CurrentHeight(), 100))
+		assert.False(t, td.sandbox.VerifyProof(validStamp, validProof, activatingVal))
+	})
 }
@@ -0,0 +1,17 @@
+package state
+
+type Config struct {
+	// VerifyConsistency enables verifying the consistency of the sandbox after executing a block.
+	// It is useful for debugging, but it slows down the block execution.
+	VerifyConsistency bool `toml:"verify_consistency"`
+
+	// BatchVerifySignatures enables verifying the signatures of the block transactions concurrently.
+	BatchVerifySignatures bool `toml:"batch_verify_signatures"`
+}
+
+func DefaultConfig() *Config {
+	return &Config{
+		VerifyConsistency:     false,
+		BatchVerifySignatures: false,
+	}
+}
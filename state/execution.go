@@ -12,6 +12,12 @@ import (
 func (st *state) executeBlock(b *block.Block, sb sandbox.Sandbox) error {
 	exe := execution.NewExecutor()
 
+	// Track the uptime of the committee members based on the previous certificate.
+	execution.UpdateUptime(b.PrevCertificate(), sb)
+
+	// Part of the block reward goes to the committee members who signed the previous block.
+	committeeReward := execution.DistributeCommitteeReward(b.PrevCertificate(), sb)
+
 	var subsidyTrx *tx.Tx
 	for i, trx := range b.Transactions() {
 		// The first transaction should be subsidy transaction
@@ -35,17 +41,26 @@ func (st *state) executeBlock(b *block.Block, sb sandbox.Sandbox) error {
 		}
 	}
 
-	accumulatedFee := exe.AccumulatedFee()
-	subsidyAmt := st.params.BlockReward + exe.AccumulatedFee()
+	feeShares := execution.SplitFee(exe.AccumulatedFee(), st.params)
+	subsidyAmt := st.params.BlockReward - committeeReward + feeShares.Proposer
 	if subsidyTrx.Payload().Value() != subsidyAmt {
 		return errors.Errorf(errors.ErrInvalidTx,
 			"invalid subsidy amount, expected %v, got %v", subsidyAmt, subsidyTrx.Payload().Value())
 	}
 
-	// Claim accumulated fees
+	// Claim accumulated fees.
+	// The proposer's share is paid by the subsidy transaction, and
+	// the burned share is not claimed, which decreases the total supply.
 	acc := sb.Account(crypto.TreasuryAddress)
-	acc.AddToBalance(accumulatedFee)
+	acc.AddToBalance(feeShares.Proposer + feeShares.Treasury)
 	sb.UpdateAccount(crypto.TreasuryAddress, acc)
 
+	if st.config.VerifyConsistency {
+		if err := sb.VerifyConsistency(); err != nil {
+			st.logger.Error("sandbox is not consistent", "err", err)
+			return err
+		}
+	}
+
 	return nil
 }
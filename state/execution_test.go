@@ -55,8 +55,8 @@ func TestExecuteBlock(t *testing.T) {
 
 	proposerAddr := td.RandomAddress()
 	rewardAddr := td.RandomAddress()
-	invSubsidyTx := td.state1.createSubsidyTx(rewardAddr, 1001)
-	validSubsidyTx := td.state1.createSubsidyTx(rewardAddr, 1000)
+	invSubsidyTx := td.state1.createSubsidyTx(rewardAddr, 1001, 0)
+	validSubsidyTx := td.state1.createSubsidyTx(rewardAddr, 1000, 0)
 	invTransferTx, _ := td.GenerateTestTransferTx()
 
 	validTx1 := tx.NewTransferTx(b1.Stamp(), 1, td.valSigner1.Address(), td.valSigner1.Address(), 1, 1000, "")
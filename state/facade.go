@@ -3,6 +3,7 @@ package state
 import (
 	"time"
 
+	"github.com/pactus-project/pactus/committee"
 	"github.com/pactus-project/pactus/crypto"
 	"github.com/pactus-project/pactus/crypto/hash"
 	"github.com/pactus-project/pactus/genesis"
@@ -26,6 +27,7 @@ type Facade interface {
 	ValidateBlock(block *block.Block) error
 	CommitBlock(height uint32, block *block.Block, cert *block.Certificate) error
 	CommitteeValidators() []*validator.Validator
+	CommitteeChanges(fromHeight uint32) []committee.Change
 	IsInCommittee(addr crypto.Address) bool
 	Proposer(round int16) *validator.Validator
 	IsProposer(addr crypto.Address, round int16) bool
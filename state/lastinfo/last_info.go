@@ -162,5 +162,12 @@ func (li *LastInfo) restoreCommittee(b *block.Block, committeeSize int) (committ
 	}
 	committee.Update(li.lastCertificate.Round(), joinedVals)
 
+	// Validators that were jailed in the last block have left the committee.
+	for _, val := range vals {
+		if val.JailedHeight() == li.lastBlockHeight {
+			committee.Remove(val.Address())
+		}
+	}
+
 	return committee, nil
 }
@@ -114,6 +114,9 @@ func (m *MockState) ValidateBlock(_ *block.Block) error {
 func (m *MockState) CommitteeValidators() []*validator.Validator {
 	return m.TestCommittee.Validators()
 }
+func (m *MockState) CommitteeChanges(_ uint32) []committee.Change {
+	return nil
+}
 func (m *MockState) IsInCommittee(addr crypto.Address) bool {
 	return m.TestCommittee.Contains(addr)
 }
@@ -28,15 +28,21 @@ import (
 	"github.com/pactus-project/pactus/www/nanomsg/event"
 )
 
+// committeeLogWindow is the number of heights that the committee change log keeps.
+const committeeLogWindow = 8640 // one day
+
 type state struct {
 	lk sync.RWMutex
 
+	config          *Config
 	signers         []crypto.Signer
 	genDoc          *genesis.Genesis
 	store           store.Store
 	params          param.Params
 	txPool          txpool.TxPool
 	committee       committee.Committee
+	committeeLog    *committee.ChangeLog
+	committeeLogWin uint32
 	totalPower      int64
 	lastInfo        *lastinfo.LastInfo
 	accountMerkle   *persistentmerkle.Tree
@@ -46,11 +52,13 @@ type state struct {
 }
 
 func LoadOrNewState(
+	conf *Config,
 	genDoc *genesis.Genesis,
 	signers []crypto.Signer,
 	store store.Store,
 	txPool txpool.TxPool, eventCh chan event.Event) (Facade, error) {
 	st := &state{
+		config:          conf,
 		signers:         signers,
 		genDoc:          genDoc,
 		txPool:          txPool,
@@ -60,6 +68,7 @@ func LoadOrNewState(
 		accountMerkle:   persistentmerkle.New(),
 		validatorMerkle: persistentmerkle.New(),
 		eventCh:         eventCh,
+		committeeLogWin: committeeLogWindow,
 	}
 	st.logger = logger.NewLogger("_state", st)
 	st.store = store
@@ -110,12 +119,15 @@ func (st *state) tryLoadLastInfo() error {
 	}
 
 	logger.Debug("try to restore the last state")
-	committee, err := st.lastInfo.RestoreLastInfo(st.params.CommitteeSize)
+	cmt, err := st.lastInfo.RestoreLastInfo(st.params.CommitteeSize)
 	if err != nil {
 		return err
 	}
 
-	st.committee = committee
+	st.committee = cmt
+	if err := st.loadCommitteeLog(); err != nil {
+		return err
+	}
 
 	logger.Info("last state restored",
 		"last height", st.lastInfo.BlockHeight(),
@@ -124,6 +136,54 @@ func (st *state) tryLoadLastInfo() error {
 	return nil
 }
 
+// loadCommitteeLog rebuilds the committee change log from the certificates of the stored blocks,
+// so it is the same as the log of a node that has been running since the genesis.
+func (st *state) loadCommitteeLog() error {
+	nextHeight := st.lastInfo.BlockHeight() + 1
+	fromHeight := uint32(1)
+	if nextHeight > st.committeeLogWin {
+		fromHeight = nextHeight - st.committeeLogWin + 1
+	}
+
+	committers, err := st.committersAt(fromHeight)
+	if err != nil {
+		return err
+	}
+	log := committee.NewChangeLog(fromHeight, committers)
+	for height := fromHeight + 1; height <= nextHeight; height++ {
+		committers, err := st.committersAt(height)
+		if err != nil {
+			return err
+		}
+		if err := log.Apply(height, committers); err != nil {
+			return err
+		}
+	}
+	st.committeeLog = log
+
+	return nil
+}
+
+// committersAt returns the committers of the given height.
+// The committers of the stored heights are read from their certificates.
+func (st *state) committersAt(height uint32) ([]int32, error) {
+	lastHeight := st.lastInfo.BlockHeight()
+	if height == lastHeight+1 {
+		return st.committee.Committers(), nil
+	}
+	if height == lastHeight {
+		return st.lastInfo.Certificate().Committers(), nil
+	}
+
+	// Each block contains the certificate of the previous height
+	storedBlock, err := st.store.Block(height + 1)
+	if err != nil {
+		return nil, err
+	}
+
+	return storedBlock.ToBlock().PrevCertificate().Committers(), nil
+}
+
 func (st *state) makeGenesisState(genDoc *genesis.Genesis) error {
 	accs := genDoc.Accounts()
 	for addr, acc := range accs {
@@ -140,11 +200,12 @@ func (st *state) makeGenesisState(genDoc *genesis.Genesis) error {
 		return err
 	}
 
-	committee, err := committee.NewCommittee(vals, st.params.CommitteeSize, vals[0].Address())
+	cmt, err := committee.NewCommittee(vals, st.params.CommitteeSize, vals[0].Address())
 	if err != nil {
 		return err
 	}
-	st.committee = committee
+	st.committee = cmt
+	st.committeeLog = committee.NewChangeLog(1, cmt.Committers())
 	st.lastInfo.SetBlockTime(genDoc.GenesisTime())
 
 	return nil
@@ -277,7 +338,10 @@ func (st *state) UpdateLastCertificate(cert *block.Certificate) error {
 	return nil
 }
 
-func (st *state) createSubsidyTx(rewardAddr crypto.Address, fee int64) *tx.Tx {
+// createSubsidyTx creates the subsidy transaction that pays the block reward and
+// the transaction fees to the proposer. The part of the block reward that is
+// distributed among the committee is excluded.
+func (st *state) createSubsidyTx(rewardAddr crypto.Address, fee, committeeReward int64) *tx.Tx {
 	acc, err := st.store.Account(crypto.TreasuryAddress)
 	if err != nil {
 		// TODO: This can happen when a node is shutting down
@@ -287,7 +351,7 @@ func (st *state) createSubsidyTx(rewardAddr crypto.Address, fee int64) *tx.Tx {
 	}
 	stamp := st.lastInfo.BlockHash().Stamp()
 	seq := acc.Sequence() + 1
-	tx := tx.NewSubsidyTx(stamp, seq, rewardAddr, st.params.BlockReward+fee, "")
+	tx := tx.NewSubsidyTx(stamp, seq, rewardAddr, st.params.BlockReward-committeeReward+fee, "")
 	return tx
 }
 
@@ -303,6 +367,11 @@ func (st *state) ProposeBlock(signer crypto.Signer, rewardAddr crypto.Address, r
 	sb := st.concreteSandbox()
 	exe := execution.NewExecutor()
 
+	// The previous certificate will be included in the block.
+	// Jailed validators should be known before executing the transactions.
+	execution.UpdateUptime(st.lastInfo.Certificate(), sb)
+	committeeReward := execution.DistributeCommitteeReward(st.lastInfo.Certificate(), sb)
+
 	// Re-check all transactions strictly and remove invalid ones
 	txs := st.txPool.PrepareBlockTransactions()
 	for i := 0; i < txs.Len(); i++ {
@@ -326,7 +395,8 @@ func (st *state) ProposeBlock(signer crypto.Signer, rewardAddr crypto.Address, r
 		}
 	}
 
-	subsidyTx := st.createSubsidyTx(rewardAddr, exe.AccumulatedFee())
+	feeShares := execution.SplitFee(exe.AccumulatedFee(), st.params)
+	subsidyTx := st.createSubsidyTx(rewardAddr, feeShares.Proposer, committeeReward)
 	if subsidyTx == nil {
 		// probably the node is shutting down.
 		st.logger.Error("no subsidy transaction")
@@ -475,7 +545,15 @@ func (st *state) evaluateSortition() bool {
 			continue
 		}
 
-		ok, proof := sortition.EvaluateSortition(st.lastInfo.SortitionSeed(), signer, st.totalPower, val.Power())
+		if val.IsJailed() {
+			// we are in jail
+			continue
+		}
+
+		// The power is evaluated at the committed height. The proof is verified at a later height,
+		// where the effective power is not less than this one.
+		power := val.EffectivePower(st.lastInfo.BlockHeight(), st.params.StakeActivationInterval)
+		ok, proof := sortition.EvaluateSortition(st.lastInfo.SortitionSeed(), signer, st.totalPower, power)
 		if ok {
 			trx := tx.NewSortitionTx(st.lastInfo.BlockHash().Stamp(), val.Sequence()+1, val.Address(), proof)
 			signer.SignMsg(trx)
@@ -483,12 +561,12 @@ func (st *state) evaluateSortition() bool {
 			err := st.txPool.AppendTxAndBroadcast(trx)
 			if err == nil {
 				st.logger.Info("sortition transaction broadcasted",
-					"address", signer.Address(), "power", val.Power(), "tx", trx)
+					"address", signer.Address(), "power", power, "tx", trx)
 
 				evaluated = true
 			} else {
 				st.logger.Error("our sortition transaction is invalid!",
-					"address", signer.Address(), "power", val.Power(), "tx", trx, "err", err)
+					"address", signer.Address(), "power", power, "tx", trx, "err", err)
 			}
 		}
 	}
@@ -505,6 +583,7 @@ func (st *state) Fingerprint() string {
 
 func (st *state) commitSandbox(sb sandbox.Sandbox, round int16) {
 	joined := make([]*validator.Validator, 0)
+	jailed := make([]*validator.Validator, 0)
 	currentHeight := sb.CurrentHeight()
 	sb.IterateValidators(func(val *validator.Validator, updated bool) {
 		if val.LastJoinedHeight() == currentHeight {
@@ -512,9 +591,25 @@ func (st *state) commitSandbox(sb sandbox.Sandbox, round int16) {
 
 			joined = append(joined, val)
 		}
+		if val.JailedHeight() == currentHeight {
+			jailed = append(jailed, val)
+		}
 	})
 	st.committee.Update(round, joined)
 
+	// Jailed validators leave the committee immediately
+	for _, val := range jailed {
+		if st.committee.Remove(val.Address()) {
+			st.logger.Info("jailed validator left the committee", "address", val.Address())
+		}
+	}
+	if err := st.committeeLog.Apply(currentHeight+1, st.committee.Committers()); err != nil {
+		st.logger.Error("unable to record committee changes", "err", err)
+	}
+	if currentHeight+1 > st.committeeLogWin {
+		st.committeeLog.Prune(currentHeight + 2 - st.committeeLogWin)
+	}
+
 	sb.IterateAccounts(func(addr crypto.Address, acc *account.Account, updated bool) {
 		if updated {
 			st.store.UpdateAccount(addr, acc)
@@ -563,7 +658,7 @@ func (st *state) CommitteePower() int64 {
 	st.lk.RLock()
 	defer st.lk.RUnlock()
 
-	return st.committee.TotalPower()
+	return st.committee.TotalEffectivePower(st.lastInfo.BlockHeight()+1, st.params.StakeActivationInterval)
 }
 
 func (st *state) proposeNextBlockTime() time.Time {
@@ -584,6 +679,15 @@ func (st *state) CommitteeValidators() []*validator.Validator {
 	return st.committee.Validators()
 }
 
+// CommitteeChanges returns the changes of the committee members from the given height onward.
+// Only the changes of the recent heights are kept.
+func (st *state) CommitteeChanges(fromHeight uint32) []committee.Change {
+	st.lk.RLock()
+	defer st.lk.RUnlock()
+
+	return st.committeeLog.Changes(fromHeight)
+}
+
 func (st *state) TotalAccounts() int32 {
 	return st.store.TotalAccounts()
 }
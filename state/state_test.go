@@ -5,6 +5,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/pactus-project/pactus/committee"
 	"github.com/pactus-project/pactus/crypto"
 	"github.com/pactus-project/pactus/crypto/bls"
 	"github.com/pactus-project/pactus/crypto/hash"
@@ -39,6 +40,14 @@ type testData struct {
 	commonTxPool *txpool.MockTxPool
 }
 
+func testConfig() *Config {
+	conf := DefaultConfig()
+	conf.VerifyConsistency = true
+	conf.BatchVerifySignatures = true
+
+	return conf
+}
+
 func setup(t *testing.T) *testData {
 	ts := testsuite.NewTestSuite(t)
 
@@ -74,13 +83,13 @@ func setup(t *testing.T) *testData {
 	vals := []*validator.Validator{val1, val2, val3, val4}
 	gnDoc := genesis.MakeGenesis(genTime, accs, vals, params)
 
-	st1, err := LoadOrNewState(gnDoc, []crypto.Signer{valSigner1}, store1, commonTxPool, nil)
+	st1, err := LoadOrNewState(testConfig(), gnDoc, []crypto.Signer{valSigner1}, store1, commonTxPool, nil)
 	require.NoError(t, err)
-	st2, err := LoadOrNewState(gnDoc, []crypto.Signer{valSigner2}, store2, commonTxPool, nil)
+	st2, err := LoadOrNewState(testConfig(), gnDoc, []crypto.Signer{valSigner2}, store2, commonTxPool, nil)
 	require.NoError(t, err)
-	st3, err := LoadOrNewState(gnDoc, []crypto.Signer{valSigner3}, store3, commonTxPool, nil)
+	st3, err := LoadOrNewState(testConfig(), gnDoc, []crypto.Signer{valSigner3}, store3, commonTxPool, nil)
 	require.NoError(t, err)
-	st4, err := LoadOrNewState(gnDoc, []crypto.Signer{valSigner4}, store4, commonTxPool, nil)
+	st4, err := LoadOrNewState(testConfig(), gnDoc, []crypto.Signer{valSigner4}, store4, commonTxPool, nil)
 	require.NoError(t, err)
 
 	state1, _ := st1.(*state)
@@ -199,13 +208,195 @@ func TestBlockSubsidyTx(t *testing.T) {
 
 	// Without reward address in config
 	rewardAddr := td.RandomAddress()
-	trx := td.state1.createSubsidyTx(rewardAddr, 7)
+	trx := td.state1.createSubsidyTx(rewardAddr, 7, 0)
 	assert.True(t, trx.IsSubsidyTx())
 	assert.Equal(t, trx.Payload().Value(), td.state1.params.BlockReward+7)
 	assert.Equal(t, trx.Payload().(*payload.TransferPayload).Sender, crypto.TreasuryAddress)
 	assert.Equal(t, trx.Payload().(*payload.TransferPayload).Receiver, rewardAddr)
 }
 
+func TestCommitteeRewardSplit(t *testing.T) {
+	td := setup(t)
+
+	for _, st := range []*state{td.state1, td.state2, td.state3, td.state4} {
+		st.params.CommitteeRewardFraction = 0.3
+	}
+
+	// No reward for the committee in the first block, since it has no previous certificate
+	td.moveToNextHeightForAllStates(t)
+
+	balance := func(addr crypto.Address) int64 {
+		acc, err := td.state1.store.Account(addr)
+		if err != nil {
+			return 0
+		}
+		return acc.Balance()
+	}
+	signers := []crypto.Signer{td.valSigner1, td.valSigner2, td.valSigner3, td.valSigner4}
+	balances := make([]int64, len(signers))
+	for i, s := range signers {
+		balances[i] = balance(s.Address())
+	}
+	treasuryBalance := balance(crypto.TreasuryAddress)
+
+	// All validators signed the previous block and they have the same power.
+	b2, c2 := td.makeBlockAndCertificate(t, 0, td.valSigner1, td.valSigner2, td.valSigner3)
+	subsidyTx := b2.Transactions()[0]
+	assert.Equal(t, subsidyTx.Payload().Value(), int64(7e8))
+	td.commitBlockForAllStates(t, b2, c2)
+
+	for i, s := range signers {
+		expected := int64(75000000) // 3e8 / 4
+		if s.Address() == b2.Header().ProposerAddress() {
+			expected += subsidyTx.Payload().Value()
+		}
+		assert.Equal(t, balance(s.Address())-balances[i], expected)
+	}
+	assert.Equal(t, treasuryBalance-balance(crypto.TreasuryAddress), td.state1.params.BlockReward)
+
+	for i, s := range signers {
+		balances[i] = balance(s.Address())
+	}
+	treasuryBalance = balance(crypto.TreasuryAddress)
+
+	// Validator 4 hasn't signed the previous block,
+	// so the committee reward is split among the other three validators.
+	b3, c3 := td.makeBlockAndCertificate(t, 0, td.valSigner1, td.valSigner2, td.valSigner3)
+	subsidyTx = b3.Transactions()[0]
+	assert.Equal(t, subsidyTx.Payload().Value(), int64(7e8))
+	td.commitBlockForAllStates(t, b3, c3)
+	assert.Equal(t, td.state1.LastBlockHash(), td.state4.LastBlockHash())
+
+	paid := int64(0)
+	for i, s := range signers {
+		expected := int64(1e8) // 3e8 / 3
+		if s.Address() == td.valSigner4.Address() {
+			expected = 0
+		}
+		if s.Address() == b3.Header().ProposerAddress() {
+			expected += subsidyTx.Payload().Value()
+		}
+		received := balance(s.Address()) - balances[i]
+		assert.Equal(t, received, expected)
+		paid += received
+	}
+	assert.Equal(t, treasuryBalance-balance(crypto.TreasuryAddress), paid)
+	assert.Equal(t, paid, td.state1.params.BlockReward)
+}
+
+func TestFeePolicy(t *testing.T) {
+	tests := []struct {
+		name             string
+		treasuryFraction float64
+		burnFraction     float64
+		proposerFee      int64
+		treasuryFee      int64
+		burnedFee        int64
+	}{
+		{"to proposer", 0, 0, 1000, 0, 0},
+		{"to treasury", 1, 0, 0, 1000, 0},
+		{"burn", 0, 1, 0, 0, 1000},
+		{"split", 0.25, 0.5, 250, 250, 500},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			td := setup(t)
+
+			for _, st := range []*state{td.state1, td.state2, td.state3, td.state4} {
+				st.params.FeeTreasuryFraction = test.treasuryFraction
+				st.params.FeeBurnFraction = test.burnFraction
+			}
+
+			td.moveToNextHeightForAllStates(t)
+
+			balance := func(addr crypto.Address) int64 {
+				acc, err := td.state1.store.Account(addr)
+				if err != nil {
+					return 0
+				}
+				return acc.Balance()
+			}
+			totalSupply := func() int64 {
+				total := int64(0)
+				td.state1.store.IterateAccounts(func(_ crypto.Address, acc *account.Account) bool {
+					total += acc.Balance()
+					return false
+				})
+				td.state1.store.IterateValidators(func(val *validator.Validator) bool {
+					total += val.Stake()
+					return false
+				})
+				return total
+			}
+			treasuryBalance := balance(crypto.TreasuryAddress)
+			supply := totalSupply()
+
+			trx := tx.NewTransferTx(td.state1.lastInfo.BlockHash().Stamp(), 1, td.valSigner1.Address(),
+				td.valSigner2.Address(), 1000, 1000, "")
+			td.valSigner1.SignMsg(trx)
+			assert.NoError(t, td.commonTxPool.AppendTx(trx))
+
+			b2, c2 := td.makeBlockAndCertificate(t, 0, td.valSigner1, td.valSigner2, td.valSigner3)
+			assert.Equal(t, b2.Transactions().Len(), 2)
+			subsidyTx := b2.Transactions()[0]
+			assert.Equal(t, subsidyTx.Payload().Value(), td.state1.params.BlockReward+test.proposerFee)
+			td.commitBlockForAllStates(t, b2, c2)
+
+			assert.Equal(t, balance(crypto.TreasuryAddress)-treasuryBalance,
+				test.treasuryFee-td.state1.params.BlockReward)
+			assert.Equal(t, supply-totalSupply(), test.burnedFee)
+			assert.Equal(t, td.state1.LastBlockHash(), td.state4.LastBlockHash())
+		})
+	}
+}
+
+func TestJailedValidatorLeavesCommittee(t *testing.T) {
+	td := setup(t)
+
+	for _, st := range []*state{td.state1, td.state2, td.state3, td.state4} {
+		st.params.JailThreshold = 1
+	}
+	td.state1.committeeLogWin = 2
+
+	// Validator 4 doesn't sign the first block
+	b1, c1 := td.makeBlockAndCertificate(t, 0, td.valSigner1, td.valSigner2, td.valSigner3)
+	td.commitBlockForAllStates(t, b1, c1)
+	assert.True(t, td.state1.committee.Contains(td.valSigner4.Address()))
+
+	// Validator 4 is jailed by executing the second block
+	b2, c2 := td.makeBlockAndCertificate(t, 0, td.valSigner1, td.valSigner2, td.valSigner3)
+	td.commitBlockForAllStates(t, b2, c2)
+
+	for _, st := range []*state{td.state1, td.state2, td.state3, td.state4} {
+		val := st.ValidatorByAddress(td.valSigner4.Address())
+		assert.Equal(t, val.JailedHeight(), uint32(2))
+		assert.False(t, st.committee.Contains(td.valSigner4.Address()))
+		assert.Equal(t, st.committee.Committers(), []int32{0, 1, 2})
+		for round := int16(0); round < 3; round++ {
+			assert.NotEqual(t, st.committee.Proposer(round).Address(), td.valSigner4.Address())
+		}
+	}
+
+	// The committee should be restored without the jailed validator
+	st1Load, err := LoadOrNewState(testConfig(), td.state1.genDoc, []crypto.Signer{td.valSigner1},
+		td.state1.store, td.commonTxPool, nil)
+	require.NoError(t, err)
+	assert.Equal(t, st1Load.(*state).committee.Committers(), td.state1.committee.Committers())
+	assert.Equal(t, st1Load.(*state).committee.Proposer(0).Address(), td.state1.committee.Proposer(0).Address())
+
+	// The change log is pruned in the same way as it is rebuilt
+	assert.Equal(t, td.state1.CommitteeChanges(0), []committee.Change{{Height: 3, Joined: []int32{}, Left: []int32{3}}})
+	assert.Equal(t, td.state2.CommitteeChanges(0), td.state1.CommitteeChanges(0))
+	st1Load.(*state).committeeLogWin = 2
+	require.NoError(t, st1Load.(*state).loadCommitteeLog())
+	assert.Equal(t, st1Load.CommitteeChanges(0), td.state1.CommitteeChanges(0))
+	_, err = td.state1.committeeLog.Committers(1)
+	assert.Error(t, err)
+	_, err = st1Load.(*state).committeeLog.Committers(1)
+	assert.Error(t, err)
+}
+
 func TestCommitBlocks(t *testing.T) {
 	td := setup(t)
 
@@ -337,7 +528,7 @@ func TestBlockProposal(t *testing.T) {
 	})
 
 	t.Run("Tx pool has two subsidy transactions", func(t *testing.T) {
-		trx := td.state3.createSubsidyTx(td.RandomAddress(), 0)
+		trx := td.state3.createSubsidyTx(td.RandomAddress(), 0, 0)
 		assert.NoError(t, td.state3.txPool.AppendTx(trx))
 
 		// Moving to the next round
@@ -388,7 +579,7 @@ func TestSortition(t *testing.T) {
 	pub, prv := td.RandomBLSKeyPair()
 	signer := crypto.NewSigner(prv)
 	store := store.MockingStore(td.TestSuite)
-	St1, _ := LoadOrNewState(td.state1.genDoc, []crypto.Signer{signer}, store, td.commonTxPool, nil)
+	St1, _ := LoadOrNewState(testConfig(), td.state1.genDoc, []crypto.Signer{signer}, store, td.commonTxPool, nil)
 	stNew := St1.(*state)
 
 	assert.False(t, stNew.evaluateSortition()) //  not a validator
@@ -430,12 +621,42 @@ func TestSortition(t *testing.T) {
 	assert.True(t, td.state1.committee.Contains(td.valSigner1.Address()))
 	assert.True(t, td.state1.committee.Contains(pub.Address()))
 
+	// The new validator joins the committee at the next height
+	changes := td.state1.CommitteeChanges(0)
+	require.Len(t, changes, 1)
+	assert.Equal(t, changes[0].Height, height+1)
+	assert.Equal(t, changes[0].Joined, []int32{4})
+	assert.Empty(t, changes[0].Left)
+	assert.Empty(t, td.state1.CommitteeChanges(height+2))
+
 	// ---------------------------------------------
 	// Let's save and load td.state1
 	td.state1.Close()
-	St1, _ = LoadOrNewState(td.state1.genDoc, []crypto.Signer{td.valSigner1}, store, td.commonTxPool, nil)
+	St1, _ = LoadOrNewState(testConfig(), td.state1.genDoc, []crypto.Signer{td.valSigner1}, store, td.commonTxPool, nil)
 	st1 := St1.(*state)
 
+	// The committee changes are rebuilt from the stored certificates
+	assert.Equal(t, st1.CommitteeChanges(0), changes)
+	assert.Equal(t, stNew.CommitteeChanges(0), changes)
+
+	// Only the changes within the window are kept
+	st1.committeeLogWin = 2
+	require.NoError(t, st1.loadCommitteeLog())
+	assert.Equal(t, st1.CommitteeChanges(0), changes)
+	_, err := st1.committeeLog.Committers(height - 1)
+	assert.Error(t, err)
+
+	st1.committeeLogWin = 1
+	require.NoError(t, st1.loadCommitteeLog())
+	assert.Empty(t, st1.CommitteeChanges(0))
+	committers, err := st1.committeeLog.Committers(height + 1)
+	require.NoError(t, err)
+	assert.Equal(t, committers, []int32{0, 1, 2, 3, 4})
+
+	st1.committeeLogWin = committeeLogWindow
+	require.NoError(t, st1.loadCommitteeLog())
+	assert.Equal(t, st1.CommitteeChanges(0), changes)
+
 	// ---------------------------------------------
 	// Let's commit another block with the new committee
 	b14, err := stNew.ProposeBlock(stNew.signers[0], td.RandomAddress(), 3)
@@ -606,7 +827,7 @@ func TestLoadState(t *testing.T) {
 	b6, c6 := td.makeBlockAndCertificate(t, 0, td.valSigner1, td.valSigner2, td.valSigner3, td.valSigner4)
 
 	// Load last state info
-	st1Load, err := LoadOrNewState(td.state1.genDoc, []crypto.Signer{td.valSigner1}, td.state1.store, td.commonTxPool, nil)
+	st1Load, err := LoadOrNewState(testConfig(), td.state1.genDoc, []crypto.Signer{td.valSigner1}, td.state1.store, td.commonTxPool, nil)
 	require.NoError(t, err)
 
 	assert.Equal(t, td.state1.store.TotalAccounts(), st1Load.(*state).store.TotalAccounts())
@@ -629,7 +850,7 @@ func TestLoadStateAfterChangingGenesis(t *testing.T) {
 		td.moveToNextHeightForAllStates(t)
 	}
 
-	_, err := LoadOrNewState(td.state1.genDoc, []crypto.Signer{td.valSigner1},
+	_, err := LoadOrNewState(testConfig(), td.state1.genDoc, []crypto.Signer{td.valSigner1},
 		td.state1.store, txpool.MockingTxPool(), nil)
 	require.NoError(t, err)
 
@@ -644,7 +865,7 @@ func TestLoadStateAfterChangingGenesis(t *testing.T) {
 		td.state1.genDoc.Params())
 
 	// Load last state info after modifying genesis
-	_, err = LoadOrNewState(genDoc, []crypto.Signer{td.valSigner1}, td.state1.store, txpool.MockingTxPool(), nil)
+	_, err = LoadOrNewState(testConfig(), genDoc, []crypto.Signer{td.valSigner1}, td.state1.store, txpool.MockingTxPool(), nil)
 	require.Error(t, err)
 }
 
@@ -718,7 +939,7 @@ func TestCommittingInvalidBlock(t *testing.T) {
 	td.moveToNextHeightForAllStates(t)
 
 	txs := block.NewTxs()
-	trx := td.state2.createSubsidyTx(td.RandomAddress(), 0)
+	trx := td.state2.createSubsidyTx(td.RandomAddress(), 0, 0)
 	txs.Append(trx)
 	b := block.MakeBlock(2, util.Now(), txs, td.state2.lastInfo.BlockHash(), td.state2.stateRoot(),
 		td.state2.lastInfo.Certificate(), td.state2.lastInfo.SortitionSeed(), td.state2.signers[0].Address())
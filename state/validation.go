@@ -9,7 +9,13 @@ import (
 )
 
 func (st *state) validateBlock(block *block.Block) error {
-	if err := block.SanityCheck(); err != nil {
+	var err error
+	if st.config.BatchVerifySignatures {
+		err = block.SanityCheckBatch()
+	} else {
+		err = block.SanityCheck()
+	}
+	if err != nil {
 		return err
 	}
 
@@ -26,7 +32,9 @@ func (st *state) validateBlock(block *block.Block) error {
 	return st.validateCertificateForPreviousHeight(block.Header().PrevBlockHash(), block.PrevCertificate())
 }
 
-func (st *state) checkCertificate(blockHash hash.Hash, cert *block.Certificate) error {
+// checkCertificate checks the certificate of the block at the given height.
+// The power of the committers excludes the stake that is not activated yet.
+func (st *state) checkCertificate(height uint32, blockHash hash.Hash, cert *block.Certificate) error {
 	if err := cert.SanityCheck(); err != nil {
 		return err
 	}
@@ -41,11 +49,12 @@ func (st *state) checkCertificate(blockHash hash.Hash, cert *block.Certificate)
 			return errors.Errorf(errors.ErrInvalidBlock,
 				"certificate has invalid committer: %x", num)
 		}
+		power := val.EffectivePower(height, st.params.StakeActivationInterval)
 		if !util.Contains(cert.Absentees(), num) {
 			pubs = append(pubs, val.PublicKey())
-			signedPower += val.Power()
+			signedPower += power
 		}
-		committeePower += val.Power()
+		committeePower += power
 	}
 
 	// Check if signers have 2/3+ of total power
@@ -72,7 +81,7 @@ func (st *state) validateCertificateForPreviousHeight(blockHash hash.Hash, cert
 				"only genesis block has no certificate")
 		}
 	} else {
-		if err := st.checkCertificate(blockHash, cert); err != nil {
+		if err := st.checkCertificate(st.lastInfo.BlockHeight(), blockHash, cert); err != nil {
 			return err
 		}
 
@@ -92,7 +101,7 @@ func (st *state) validateCertificateForPreviousHeight(blockHash hash.Hash, cert
 
 // validateCertificate validates certificate for the current height.
 func (st *state) validateCertificate(blockHash hash.Hash, cert *block.Certificate) error {
-	if err := st.checkCertificate(blockHash, cert); err != nil {
+	if err := st.checkCertificate(st.lastInfo.BlockHeight()+1, blockHash, cert); err != nil {
 		return err
 	}
 
@@ -187,7 +187,7 @@ func TestBlockValidation(t *testing.T) {
 	// ProposerAddress		(OK)
 	//
 	proposerAddr := td.state2.signers[0].Address()
-	trx := td.state2.createSubsidyTx(td.RandomAddress(), 0)
+	trx := td.state2.createSubsidyTx(td.RandomAddress(), 0, 0)
 	txs := block.NewTxs()
 	txs.Append(trx)
 
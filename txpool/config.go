@@ -1,16 +1,56 @@
 package txpool
 
 import (
+	"time"
+
+	"github.com/pactus-project/pactus/types/tx/payload"
 	"github.com/pactus-project/pactus/util/errors"
 )
 
 type Config struct {
-	MaxSize int `toml:"max_size"`
+	MaxSize    int               `toml:"max_size"`
+	Priority   *PriorityConfig   `toml:"priority"`
+	Reannounce *ReannounceConfig `toml:"reannounce"`
+}
+
+// PriorityConfig defines the priority multipliers for the fee-paying transactions.
+// The transactions are ordered by their fee multiplied by the multiplier of their type,
+// while the transactions of the same signer are kept in their sequence order.
+type PriorityConfig struct {
+	Enabled  bool    `toml:"enable"`
+	Bond     float64 `toml:"bond"`
+	Withdraw float64 `toml:"withdraw"`
+	Transfer float64 `toml:"transfer"`
+}
+
+// ReannounceConfig defines how the broadcasted transactions that are not mined yet,
+// are broadcasted again. The time between two announcements is doubled each time.
+type ReannounceConfig struct {
+	Interval time.Duration `toml:"interval"`
+	MaxCount int           `toml:"max_count"`
 }
 
 func DefaultConfig() *Config {
 	return &Config{
-		MaxSize: 2000,
+		MaxSize:    2000,
+		Priority:   DefaultPriorityConfig(),
+		Reannounce: DefaultReannounceConfig(),
+	}
+}
+
+func DefaultPriorityConfig() *PriorityConfig {
+	return &PriorityConfig{
+		Enabled:  false,
+		Bond:     2.0,
+		Withdraw: 1.0,
+		Transfer: 1.0,
+	}
+}
+
+func DefaultReannounceConfig() *ReannounceConfig {
+	return &ReannounceConfig{
+		Interval: 1 * time.Minute,
+		MaxCount: 3,
 	}
 }
 
@@ -19,9 +59,65 @@ func (conf *Config) SanityCheck() error {
 	if conf.MaxSize == 0 {
 		return errors.Errorf(errors.ErrInvalidConfig, "maxSize can't be negative or zero")
 	}
+	if err := conf.Priority.SanityCheck(); err != nil {
+		return err
+	}
+	if err := conf.Reannounce.SanityCheck(); err != nil {
+		return err
+	}
+	return nil
+}
+
+// SanityCheck performs basic checks on the configuration.
+func (conf *ReannounceConfig) SanityCheck() error {
+	if conf.Interval < 0 {
+		return errors.Errorf(errors.ErrInvalidConfig, "reannounce interval can't be negative")
+	}
+	if conf.MaxCount < 0 {
+		return errors.Errorf(errors.ErrInvalidConfig, "reannounce max count can't be negative")
+	}
+	return nil
+}
+
+// enabled returns true if the reannouncement is enabled.
+func (conf *ReannounceConfig) enabled() bool {
+	return conf.Interval > 0 && conf.MaxCount > 0
+}
+
+// backoff returns the time to wait before the next announcement,
+// given the number of announcements made so far.
+// It is the interval for the first announcement and doubled after that.
+func (conf *ReannounceConfig) backoff(count int) time.Duration {
+	return conf.Interval << count
+}
+
+// SanityCheck performs basic checks on the configuration.
+func (conf *PriorityConfig) SanityCheck() error {
+	if conf.Bond <= 0 || conf.Withdraw <= 0 || conf.Transfer <= 0 {
+		return errors.Errorf(errors.ErrInvalidConfig, "priority multipliers should be positive")
+	}
 	return nil
 }
 
+// multiplier returns the priority multiplier for the given payload type.
+// If the priority boost is disabled, the multiplier is one.
+func (conf *PriorityConfig) multiplier(t payload.Type) float64 {
+	if !conf.Enabled {
+		return 1
+	}
+
+	switch t {
+	case payload.PayloadTypeBond:
+		return conf.Bond
+	case payload.PayloadTypeWithdraw:
+		return conf.Withdraw
+	case payload.PayloadTypeTransfer:
+		return conf.Transfer
+	default:
+		return 1
+	}
+}
+
 func (conf *Config) sortitionPoolSize() int {
 	return int(float32(conf.MaxSize) * 0.05)
 }
@@ -38,6 +134,12 @@ func (conf *Config) withdrawPoolSize() int {
 	return int(float32(conf.MaxSize) * 0.05)
 }
 
+func (conf *Config) unjailPoolSize() int {
+	return int(float32(conf.MaxSize) * 0.05)
+}
+
+// sendPoolSize returns the size of the transfer pool.
+// It gives up 5% of the pool size to the unjail pool, so the total stays the same.
 func (conf *Config) sendPoolSize() int {
-	return int(float32(conf.MaxSize) * 0.8)
+	return int(float32(conf.MaxSize) * 0.75)
 }
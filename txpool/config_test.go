@@ -2,6 +2,7 @@ package txpool
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 )
@@ -15,8 +16,41 @@ func TestDefaultConfigCheck(t *testing.T) {
 			c.bondPoolSize()+
 			c.unbondPoolSize()+
 			c.withdrawPoolSize()+
+			c.unjailPoolSize()+
 			c.sortitionPoolSize(), c.MaxSize)
 
 	c.MaxSize = 0
 	assert.Error(t, c.SanityCheck())
 }
+
+func TestPriorityConfigCheck(t *testing.T) {
+	c := DefaultConfig()
+	c.Priority.Bond = 0
+	assert.Error(t, c.SanityCheck())
+
+	c = DefaultConfig()
+	c.Priority.Transfer = -1
+	assert.Error(t, c.SanityCheck())
+}
+
+func TestReannounceConfigCheck(t *testing.T) {
+	c := DefaultConfig()
+	assert.True(t, c.Reannounce.enabled())
+
+	c.Reannounce.Interval = -1
+	assert.Error(t, c.SanityCheck())
+
+	c = DefaultConfig()
+	c.Reannounce.MaxCount = -1
+	assert.Error(t, c.SanityCheck())
+
+	c = DefaultConfig()
+	c.Reannounce.MaxCount = 0
+	assert.NoError(t, c.SanityCheck())
+	assert.False(t, c.Reannounce.enabled())
+
+	c = DefaultConfig()
+	assert.Equal(t, c.Reannounce.backoff(0), 1*time.Minute)
+	assert.Equal(t, c.Reannounce.backoff(1), 2*time.Minute)
+	assert.Equal(t, c.Reannounce.backoff(2), 4*time.Minute)
+}
@@ -17,6 +17,9 @@ type Reader interface {
 type TxPool interface {
 	Reader
 
+	Start()
+	Stop()
+
 	SetNewSandboxAndRecheck(sb sandbox.Sandbox)
 	AppendTxAndBroadcast(trx *tx.Tx) error
 	AppendTx(tx *tx.Tx) error
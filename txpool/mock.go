@@ -19,6 +19,8 @@ func MockingTxPool() *MockTxPool {
 		Txs: make([]*tx.Tx, 0),
 	}
 }
+func (m *MockTxPool) Start()                                    {}
+func (m *MockTxPool) Stop()                                     {}
 func (m *MockTxPool) SetNewSandboxAndRecheck(_ sandbox.Sandbox) {}
 func (m *MockTxPool) PendingTx(id tx.ID) *tx.Tx {
 	for _, t := range m.Txs {
@@ -1,9 +1,13 @@
 package txpool
 
 import (
+	"context"
 	"fmt"
+	"sort"
 	"sync"
 
+	"github.com/benbjohnson/clock"
+	"github.com/pactus-project/pactus/crypto"
 	"github.com/pactus-project/pactus/execution"
 	"github.com/pactus-project/pactus/sandbox"
 	"github.com/pactus-project/pactus/sync/bundle/message"
@@ -21,7 +25,11 @@ type txPool struct {
 	checker     *execution.Execution
 	sandbox     sandbox.Sandbox
 	pools       map[payload.Type]*linkedmap.LinkedMap[tx.ID, *tx.Tx]
+	announces   map[tx.ID]*announce
 	broadcastCh chan message.Message
+	clock       clock.Clock
+	ctx         context.Context
+	cancel      func()
 	logger      *logger.Logger
 }
 
@@ -33,18 +41,51 @@ func NewTxPool(conf *Config, broadcastCh chan message.Message) TxPool {
 	pending[payload.PayloadTypeUnbond] = linkedmap.NewLinkedMap[tx.ID, *tx.Tx](conf.unbondPoolSize())
 	pending[payload.PayloadTypeWithdraw] = linkedmap.NewLinkedMap[tx.ID, *tx.Tx](conf.withdrawPoolSize())
 	pending[payload.PayloadTypeSortition] = linkedmap.NewLinkedMap[tx.ID, *tx.Tx](conf.sortitionPoolSize())
+	pending[payload.PayloadTypeUnjail] = linkedmap.NewLinkedMap[tx.ID, *tx.Tx](conf.unjailPoolSize())
 
+	ctx, cancel := context.WithCancel(context.Background())
 	pool := &txPool{
 		config:      conf,
 		checker:     execution.NewChecker(),
 		pools:       pending,
+		announces:   make(map[tx.ID]*announce),
 		broadcastCh: broadcastCh,
+		clock:       clock.New(),
+		ctx:         ctx,
+		cancel:      cancel,
 	}
 
 	pool.logger = logger.NewLogger("_pool", pool)
 	return pool
 }
 
+// Start starts reannouncing the pending transactions periodically.
+func (p *txPool) Start() {
+	if !p.config.Reannounce.enabled() {
+		return
+	}
+
+	ticker := p.clock.Ticker(p.config.Reannounce.Interval)
+	go func() {
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-p.ctx.Done():
+				return
+			case now := <-ticker.C:
+				p.lk.Lock()
+				p.reannounce(now)
+				p.lk.Unlock()
+			}
+		}
+	}()
+}
+
+func (p *txPool) Stop() {
+	p.cancel()
+}
+
 func (p *txPool) SetNewSandboxAndRecheck(sb sandbox.Sandbox) {
 	p.lk.Lock()
 	defer p.lk.Unlock()
@@ -61,6 +102,7 @@ func (p *txPool) SetNewSandboxAndRecheck(sb sandbox.Sandbox) {
 			if err := p.checkTx(trx); err != nil {
 				p.logger.Debug("invalid transaction after rechecking", "id", trx.ID())
 				pool.Remove(trx.ID())
+				delete(p.announces, trx.ID())
 			}
 		}
 	}
@@ -85,6 +127,13 @@ func (p *txPool) AppendTxAndBroadcast(trx *tx.Tx) error {
 		return err
 	}
 
+	if _, ok := p.announces[trx.ID()]; !ok && p.config.Reannounce.enabled() {
+		p.announces[trx.ID()] = &announce{
+			trx:         trx,
+			announcedAt: p.clock.Now(),
+		}
+	}
+
 	go func(t *tx.Tx) {
 		p.broadcastCh <- message.NewTransactionsMessage([]*tx.Tx{t})
 	}(trx)
@@ -121,6 +170,7 @@ func (p *txPool) RemoveTx(id tx.ID) {
 	p.lk.Lock()
 	defer p.lk.Unlock()
 
+	delete(p.announces, id)
 	for _, pool := range p.pools {
 		if pool.Remove(id) {
 			break
@@ -156,6 +206,34 @@ func (p *txPool) PrepareBlockTransactions() block.Txs {
 		trxs = append(trxs, n.Data.Value)
 	}
 
+	if p.config.Priority.Enabled {
+		// Appending unbond transactions
+		poolUnbond := p.pools[payload.PayloadTypeUnbond]
+		for n := poolUnbond.HeadNode(); n != nil; n = n.Next {
+			trxs = append(trxs, n.Data.Value)
+		}
+
+		// Appending unjail transactions
+		poolUnjail := p.pools[payload.PayloadTypeUnjail]
+		for n := poolUnjail.HeadNode(); n != nil; n = n.Next {
+			trxs = append(trxs, n.Data.Value)
+		}
+
+		// Appending fee-paying transactions, ordered by their priority
+		feeTrxs := make([]*tx.Tx, 0)
+		for _, t := range []payload.Type{
+			payload.PayloadTypeBond,
+			payload.PayloadTypeWithdraw,
+			payload.PayloadTypeTransfer,
+		} {
+			for n := p.pools[t].HeadNode(); n != nil; n = n.Next {
+				feeTrxs = append(feeTrxs, n.Data.Value)
+			}
+		}
+
+		return append(trxs, p.orderByPriority(feeTrxs)...)
+	}
+
 	// Appending bond transactions
 	poolBond := p.pools[payload.PayloadTypeBond]
 	for n := poolBond.HeadNode(); n != nil; n = n.Next {
@@ -168,6 +246,12 @@ func (p *txPool) PrepareBlockTransactions() block.Txs {
 		trxs = append(trxs, n.Data.Value)
 	}
 
+	// Appending unjail transactions
+	poolUnjail := p.pools[payload.PayloadTypeUnjail]
+	for n := poolUnjail.HeadNode(); n != nil; n = n.Next {
+		trxs = append(trxs, n.Data.Value)
+	}
+
 	// Appending withdraw transactions
 	poolWithdraw := p.pools[payload.PayloadTypeWithdraw]
 	for n := poolWithdraw.HeadNode(); n != nil; n = n.Next {
@@ -183,10 +267,64 @@ func (p *txPool) PrepareBlockTransactions() block.Txs {
 	return trxs
 }
 
+// orderByPriority orders the transactions of different signers by their priority.
+// The transactions of the same signer are kept in their sequence order,
+// otherwise the later ones would be rejected for an invalid sequence.
+// At each step, it picks the signer whose next transaction has the highest priority.
+// Ties are resolved in favor of the signer that appeared first.
+func (p *txPool) orderByPriority(trxs []*tx.Tx) []*tx.Tx {
+	signers := make([]crypto.Address, 0)
+	queues := make(map[crypto.Address][]*tx.Tx)
+	for _, trx := range trxs {
+		signer := trx.Payload().Signer()
+		if _, ok := queues[signer]; !ok {
+			signers = append(signers, signer)
+		}
+		queues[signer] = append(queues[signer], trx)
+	}
+
+	for _, queue := range queues {
+		sort.SliceStable(queue, func(i, j int) bool {
+			return queue[i].Sequence() < queue[j].Sequence()
+		})
+	}
+
+	ordered := make([]*tx.Tx, 0, len(trxs))
+	for len(ordered) < len(trxs) {
+		var best crypto.Address
+		bestPriority := float64(-1)
+		for _, signer := range signers {
+			queue := queues[signer]
+			if len(queue) == 0 {
+				continue
+			}
+			if priority := p.priority(queue[0]); priority > bestPriority {
+				best = signer
+				bestPriority = priority
+			}
+		}
+
+		ordered = append(ordered, queues[best][0])
+		queues[best] = queues[best][1:]
+	}
+
+	return ordered
+}
+
+// priority returns the priority of the transaction,
+// which is its fee multiplied by the priority multiplier of its type.
+func (p *txPool) priority(trx *tx.Tx) float64 {
+	return float64(trx.Fee()) * p.config.Priority.multiplier(trx.Payload().Type())
+}
+
 func (p *txPool) HasTx(id tx.ID) bool {
 	p.lk.RLock()
 	defer p.lk.RUnlock()
 
+	return p.hasTx(id)
+}
+
+func (p *txPool) hasTx(id tx.ID) bool {
 	for _, pool := range p.pools {
 		if pool.Has(id) {
 			return true
@@ -208,11 +346,12 @@ func (p *txPool) Size() int {
 }
 
 func (p *txPool) Fingerprint() string {
-	return fmt.Sprintf("{💸 %v 🔐 %v 🔓 %v 🎯 %v 🧾 %v}",
+	return fmt.Sprintf("{💸 %v 🔐 %v 🔓 %v 🎯 %v 🧾 %v 🔑 %v}",
 		p.pools[payload.PayloadTypeTransfer].Size(),
 		p.pools[payload.PayloadTypeBond].Size(),
 		p.pools[payload.PayloadTypeUnbond].Size(),
 		p.pools[payload.PayloadTypeSortition].Size(),
 		p.pools[payload.PayloadTypeWithdraw].Size(),
+		p.pools[payload.PayloadTypeUnjail].Size(),
 	)
 }
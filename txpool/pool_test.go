@@ -5,6 +5,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/benbjohnson/clock"
 	"github.com/pactus-project/pactus/crypto/bls"
 	"github.com/pactus-project/pactus/sandbox"
 	"github.com/pactus-project/pactus/sync/bundle/message"
@@ -68,6 +69,17 @@ func (td *testData) shouldPublishTransaction(t *testing.T, id tx.ID) {
 	}
 }
 
+func (td *testData) shouldNotPublishTransaction(t *testing.T) {
+	timeout := time.NewTimer(100 * time.Millisecond)
+
+	select {
+	case <-timeout.C:
+		return
+	case msg := <-td.ch:
+		require.NoError(t, fmt.Errorf("unexpected message: %s", msg))
+	}
+}
+
 func TestAppendAndRemove(t *testing.T) {
 	td := setup(t)
 
@@ -148,6 +160,13 @@ func TestPrepareBlockTransactions(t *testing.T) {
 	val3.AddToStake(10000000000)
 	td.sandbox.UpdateValidator(val3)
 
+	val4Signer := td.RandomSigner()
+	val4Pub := val4Signer.PublicKey().(*bls.PublicKey)
+	val4 := validator.NewValidator(val4Pub, 0)
+	val4.AddToStake(10000000000)
+	val4.UpdateJailedHeight(1)
+	td.sandbox.UpdateValidator(val4)
+
 	transferTx := tx.NewTransferTx(block1000000.Stamp(), acc1.Sequence()+1, acc1Signer.Address(),
 		td.RandomAddress(), 1000, 1000, "send-tx")
 	acc1Signer.SignMsg(transferTx)
@@ -169,19 +188,122 @@ func TestPrepareBlockTransactions(t *testing.T) {
 		td.RandomProof())
 	val3Signer.SignMsg(sortitionTx)
 
+	unjailTx := tx.NewUnjailTx(block1000000.Stamp(), val4.Sequence()+1, val4.Address(), "unjail-tx")
+	val4Signer.SignMsg(unjailTx)
+
 	assert.NoError(t, td.pool.AppendTx(transferTx))
 	assert.NoError(t, td.pool.AppendTx(unbondTx))
 	assert.NoError(t, td.pool.AppendTx(withdrawTx))
 	assert.NoError(t, td.pool.AppendTx(bondTx))
 	assert.NoError(t, td.pool.AppendTx(sortitionTx))
+	assert.NoError(t, td.pool.AppendTx(unjailTx))
 
 	trxs := td.pool.PrepareBlockTransactions()
-	assert.Len(t, trxs, 5)
+	assert.Len(t, trxs, 6)
 	assert.Equal(t, trxs[0].ID(), sortitionTx.ID())
 	assert.Equal(t, trxs[1].ID(), bondTx.ID())
 	assert.Equal(t, trxs[2].ID(), unbondTx.ID())
-	assert.Equal(t, trxs[3].ID(), withdrawTx.ID())
-	assert.Equal(t, trxs[4].ID(), transferTx.ID())
+	assert.Equal(t, trxs[3].ID(), unjailTx.ID())
+	assert.Equal(t, trxs[4].ID(), withdrawTx.ID())
+	assert.Equal(t, trxs[5].ID(), transferTx.ID())
+}
+
+func TestPriorityBoost(t *testing.T) {
+	td := setup(t)
+
+	block1000000 := td.sandbox.TestStore.AddTestBlock(1000000)
+
+	acc1Signer := td.RandomSigner()
+	acc1 := account.NewAccount(0)
+	acc1.AddToBalance(10000000000)
+	td.sandbox.UpdateAccount(acc1Signer.Address(), acc1)
+
+	acc2Signer := td.RandomSigner()
+	acc2 := account.NewAccount(1)
+	acc2.AddToBalance(10000000000)
+	td.sandbox.UpdateAccount(acc2Signer.Address(), acc2)
+
+	transferTx := tx.NewTransferTx(block1000000.Stamp(), acc1.Sequence()+1, acc1Signer.Address(),
+		td.RandomAddress(), 15000000, 1500, "send-tx")
+	acc1Signer.SignMsg(transferTx)
+
+	pub, _ := td.RandomBLSKeyPair()
+	bondTx := tx.NewBondTx(block1000000.Stamp(), acc2.Sequence()+1, acc2Signer.Address(),
+		pub.Address(), pub, 1000, 1000, "bond-tx")
+	acc2Signer.SignMsg(bondTx)
+
+	assert.NoError(t, td.pool.AppendTx(transferTx))
+	assert.NoError(t, td.pool.AppendTx(bondTx))
+
+	t.Run("Boost disabled, pool order", func(t *testing.T) {
+		td.pool.config.Priority.Enabled = false
+
+		trxs := td.pool.PrepareBlockTransactions()
+		assert.Len(t, trxs, 2)
+		assert.Equal(t, trxs[0].ID(), bondTx.ID())
+		assert.Equal(t, trxs[1].ID(), transferTx.ID())
+	})
+
+	t.Run("Boost enabled, lower fee bond first", func(t *testing.T) {
+		td.pool.config.Priority.Enabled = true
+		td.pool.config.Priority.Bond = 2.0
+
+		trxs := td.pool.PrepareBlockTransactions()
+		assert.Len(t, trxs, 2)
+		assert.Equal(t, trxs[0].ID(), bondTx.ID())
+		assert.Equal(t, trxs[1].ID(), transferTx.ID())
+	})
+
+	t.Run("Boost enabled, but not enough", func(t *testing.T) {
+		td.pool.config.Priority.Enabled = true
+		td.pool.config.Priority.Bond = 1.2
+
+		trxs := td.pool.PrepareBlockTransactions()
+		assert.Len(t, trxs, 2)
+		assert.Equal(t, trxs[0].ID(), transferTx.ID())
+		assert.Equal(t, trxs[1].ID(), bondTx.ID())
+	})
+}
+
+func TestPriorityBoostSameSigner(t *testing.T) {
+	td := setup(t)
+
+	block1000000 := td.sandbox.TestStore.AddTestBlock(1000000)
+
+	acc1Signer := td.RandomSigner()
+	acc1 := account.NewAccount(0)
+	acc1.AddToBalance(10000000000)
+	td.sandbox.UpdateAccount(acc1Signer.Address(), acc1)
+
+	acc2Signer := td.RandomSigner()
+	acc2 := account.NewAccount(1)
+	acc2.AddToBalance(10000000000)
+	td.sandbox.UpdateAccount(acc2Signer.Address(), acc2)
+
+	// The second transaction of the first account pays more fee than the first one.
+	transferTx1 := tx.NewTransferTx(block1000000.Stamp(), acc1.Sequence()+1, acc1Signer.Address(),
+		td.RandomAddress(), 10000000, 1000, "send-tx-1")
+	acc1Signer.SignMsg(transferTx1)
+
+	transferTx2 := tx.NewTransferTx(block1000000.Stamp(), acc1.Sequence()+2, acc1Signer.Address(),
+		td.RandomAddress(), 30000000, 3000, "send-tx-2")
+	acc1Signer.SignMsg(transferTx2)
+
+	transferTx3 := tx.NewTransferTx(block1000000.Stamp(), acc2.Sequence()+1, acc2Signer.Address(),
+		td.RandomAddress(), 20000000, 2000, "send-tx-3")
+	acc2Signer.SignMsg(transferTx3)
+
+	assert.NoError(t, td.pool.AppendTx(transferTx1))
+	assert.NoError(t, td.pool.AppendTx(transferTx2))
+	assert.NoError(t, td.pool.AppendTx(transferTx3))
+
+	td.pool.config.Priority.Enabled = true
+
+	trxs := td.pool.PrepareBlockTransactions()
+	assert.Len(t, trxs, 3)
+	assert.Equal(t, trxs[0].ID(), transferTx3.ID())
+	assert.Equal(t, trxs[1].ID(), transferTx1.ID())
+	assert.Equal(t, trxs[2].ID(), transferTx2.ID())
 }
 
 func TestAppendAndBroadcast(t *testing.T) {
@@ -213,3 +335,89 @@ func TestAddSubsidyTransactions(t *testing.T) {
 	td.pool.SetNewSandboxAndRecheck(sandbox.MockingSandbox(td.TestSuite))
 	assert.Zero(t, td.pool.Size())
 }
+
+func TestReannounce(t *testing.T) {
+	td := setup(t)
+
+	mockClock := clock.NewMock()
+	td.pool.clock = mockClock
+	td.pool.config.Reannounce = &ReannounceConfig{
+		Interval: 1 * time.Minute,
+		MaxCount: 3,
+	}
+	td.pool.Start()
+	defer td.pool.Stop()
+
+	block1000 := td.sandbox.TestStore.AddTestBlock(1000)
+
+	makeTx := func() *tx.Tx {
+		signer := td.RandomSigner()
+		acc := account.NewAccount(0)
+		acc.AddToBalance(10000000000)
+		td.sandbox.UpdateAccount(signer.Address(), acc)
+
+		trx := tx.NewTransferTx(block1000.Stamp(), acc.Sequence()+1, signer.Address(),
+			td.RandomAddress(), 1000, 1000, "reannounce")
+		signer.SignMsg(trx)
+		return trx
+	}
+
+	// No new block is committed while the clock is moving forward,
+	// so the transactions are reannounced by the pool itself.
+	t.Run("Pending transaction should be reannounced with backoff until it is mined", func(t *testing.T) {
+		trx := makeTx()
+		assert.NoError(t, td.pool.AppendTxAndBroadcast(trx))
+		td.shouldPublishTransaction(t, trx.ID())
+
+		mockClock.Add(30 * time.Second)
+		td.shouldNotPublishTransaction(t)
+
+		mockClock.Add(30 * time.Second)
+		td.shouldPublishTransaction(t, trx.ID())
+
+		// The second reannouncement is after two minutes
+		mockClock.Add(1 * time.Minute)
+		td.shouldNotPublishTransaction(t)
+
+		mockClock.Add(1 * time.Minute)
+		td.shouldPublishTransaction(t, trx.ID())
+
+		// The transaction is mined
+		td.pool.RemoveTx(trx.ID())
+		mockClock.Add(1 * time.Hour)
+		td.shouldNotPublishTransaction(t)
+	})
+
+	t.Run("Transaction should not be reannounced more than the maximum count", func(t *testing.T) {
+		trx := makeTx()
+		assert.NoError(t, td.pool.AppendTxAndBroadcast(trx))
+		td.shouldPublishTransaction(t, trx.ID())
+
+		// After one, two and four minutes
+		mockClock.Add(7 * time.Minute)
+		for i := 0; i < td.pool.config.Reannounce.MaxCount; i++ {
+			td.shouldPublishTransaction(t, trx.ID())
+		}
+
+		mockClock.Add(1 * time.Hour)
+		td.shouldNotPublishTransaction(t)
+		assert.True(t, td.pool.HasTx(trx.ID()), "transaction should remain in the pool")
+	})
+
+	t.Run("Received transactions should not be reannounced", func(t *testing.T) {
+		trx := makeTx()
+		assert.NoError(t, td.pool.AppendTx(trx))
+
+		mockClock.Add(1 * time.Hour)
+		td.shouldNotPublishTransaction(t)
+	})
+
+	t.Run("Committing a new block should not reannounce", func(t *testing.T) {
+		trx := makeTx()
+		assert.NoError(t, td.pool.AppendTxAndBroadcast(trx))
+		td.shouldPublishTransaction(t, trx.ID())
+
+		td.pool.SetNewSandboxAndRecheck(td.sandbox)
+		td.shouldNotPublishTransaction(t)
+	})
+}
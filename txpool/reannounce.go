@@ -0,0 +1,52 @@
+package txpool
+
+import (
+	"time"
+
+	"github.com/pactus-project/pactus/sync/bundle/message"
+	"github.com/pactus-project/pactus/types/tx"
+)
+
+// announce keeps track of a broadcasted transaction that is not mined yet.
+type announce struct {
+	trx         *tx.Tx
+	announcedAt time.Time
+	count       int
+}
+
+// reannounce broadcasts again the transactions that are pending for a long time,
+// in case the other peers have dropped them.
+// A transaction is not announced anymore once it is mined, removed from the pool,
+// or has been reannounced for the maximum number of times.
+// It is called periodically by the pool and should be called while the pool is locked.
+func (p *txPool) reannounce(now time.Time) {
+	conf := p.config.Reannounce
+	trxs := make([]*tx.Tx, 0)
+	for id, a := range p.announces {
+		if !p.hasTx(id) {
+			// The transaction is evicted from the pool.
+			delete(p.announces, id)
+			continue
+		}
+		if now.Before(a.announcedAt.Add(conf.backoff(a.count))) {
+			continue
+		}
+
+		a.count++
+		a.announcedAt = now
+		trxs = append(trxs, a.trx)
+
+		if a.count >= conf.MaxCount {
+			delete(p.announces, id)
+		}
+	}
+
+	if len(trxs) == 0 {
+		return
+	}
+
+	p.logger.Debug("reannouncing pending transactions", "count", len(trxs))
+	go func(trxs []*tx.Tx) {
+		p.broadcastCh <- message.NewTransactionsMessage(trxs)
+	}(trxs)
+}
@@ -63,6 +63,16 @@ func (b *Block) PrevCertificate() *Certificate { return b.data.PrevCert }
 func (b *Block) Transactions() Txs             { return b.data.Txs }
 
 func (b *Block) SanityCheck() error {
+	return b.sanityCheck(false)
+}
+
+// SanityCheckBatch is the same as SanityCheck,
+// but it verifies the signatures of the transactions concurrently.
+func (b *Block) SanityCheckBatch() error {
+	return b.sanityCheck(true)
+}
+
+func (b *Block) sanityCheck(batch bool) error {
 	if err := b.Header().SanityCheck(); err != nil {
 		return err
 	}
@@ -83,9 +93,21 @@ func (b *Block) SanityCheck() error {
 		}
 	}
 
-	for _, trx := range b.Transactions() {
-		if err := trx.SanityCheck(); err != nil {
-			return errors.Errorf(errors.ErrInvalidBlock, err.Error())
+	if batch {
+		for _, trx := range b.Transactions() {
+			if err := trx.BasicCheck(); err != nil {
+				return errors.Errorf(errors.ErrInvalidBlock, err.Error())
+			}
+		}
+		if i, err := tx.VerifySignatures(b.Transactions()); err != nil {
+			return errors.Errorf(errors.ErrInvalidBlock,
+				"invalid transaction at index %v: %s", i, err.Error())
+		}
+	} else {
+		for _, trx := range b.Transactions() {
+			if err := trx.SanityCheck(); err != nil {
+				return errors.Errorf(errors.ErrInvalidBlock, err.Error())
+			}
 		}
 	}
 
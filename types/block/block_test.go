@@ -48,6 +48,7 @@ func TestSanityCheck(t *testing.T) {
 		b := block.NewBlock(b0.Header(), b0.PrevCertificate(), trxs0)
 
 		assert.Error(t, b.SanityCheck())
+		assert.Error(t, b.SanityCheckBatch())
 	})
 
 	t.Run("Invalid state root hash", func(t *testing.T) {
@@ -102,6 +103,7 @@ func TestSanityCheck(t *testing.T) {
 	t.Run("Ok", func(t *testing.T) {
 		b := ts.GenerateTestBlock(nil, nil)
 		assert.NoError(t, b.SanityCheck())
+		assert.NoError(t, b.SanityCheckBatch())
 		assert.LessOrEqual(t, b.Header().Time(), time.Now())
 		assert.NotZero(t, b.Header().UnixTime())
 		assert.Equal(t, b.Header().Version(), uint8(1))
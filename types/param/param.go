@@ -15,6 +15,13 @@ type Params struct {
 	MinimumFee                int64   `cbor:"10,keyasint"`
 	MaximumFee                int64   `cbor:"11,keyasint"`
 	MaximumStake              int64   `cbor:"12,keyasint"`
+	JailThreshold             uint32  `cbor:"13,keyasint,omitempty"`
+	JailCooldown              uint32  `cbor:"14,keyasint,omitempty"`
+	StakeActivationInterval   uint32  `cbor:"15,keyasint,omitempty"`
+	MaxTotalValidators        int32   `cbor:"16,keyasint,omitempty"`
+	CommitteeRewardFraction   float64 `cbor:"17,keyasint,omitempty"`
+	FeeTreasuryFraction       float64 `cbor:"18,keyasint,omitempty"`
+	FeeBurnFraction           float64 `cbor:"19,keyasint,omitempty"`
 }
 
 func DefaultParams() Params {
@@ -31,9 +38,21 @@ func DefaultParams() Params {
 		MinimumFee:                1000,
 		MaximumFee:                1000000,
 		MaximumStake:              1000000000000,
+		JailThreshold:             0,    // disabled
+		JailCooldown:              8640, // one day
+		StakeActivationInterval:   0,    // disabled
+		MaxTotalValidators:        0,    // unlimited
+		CommitteeRewardFraction:   0,    // all to the proposer
+		FeeTreasuryFraction:       0,    // all fees to the proposer
+		FeeBurnFraction:           0,    // no fee is burned
 	}
 }
 
+// IsJailingEnabled checks if validators can be jailed for downtime.
+func (p Params) IsJailingEnabled() bool {
+	return p.JailThreshold > 0
+}
+
 func (p Params) BlockTime() time.Duration {
 	return time.Duration(p.BlockTimeInSecond) * time.Second
 }
@@ -0,0 +1,66 @@
+package tx
+
+import (
+	"runtime"
+	"sync"
+	"sync/atomic"
+)
+
+// VerifySignatures verifies the signatures of the transactions concurrently,
+// using a pool of workers bounded by GOMAXPROCS.
+// If all signatures are valid, it returns -1 and nil. Otherwise, it returns the index
+// of the first transaction that failed the verification and its error.
+//
+// The transactions are only read, so the same transaction can appear more than once in the batch.
+func VerifySignatures(trxs []*Tx) (int, error) {
+	return verifySignatures(trxs, runtime.GOMAXPROCS(0))
+}
+
+func verifySignatures(trxs []*Tx, workers int) (int, error) {
+	if workers > len(trxs) {
+		workers = len(trxs)
+	}
+
+	var mtx sync.Mutex
+	var wg sync.WaitGroup
+	next := int64(-1)
+	failedIndex := len(trxs)
+	var failedErr error
+
+	for w := 0; w < workers; w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			for {
+				i := int(atomic.AddInt64(&next, 1))
+				if i >= len(trxs) {
+					return
+				}
+
+				mtx.Lock()
+				done := i > failedIndex
+				mtx.Unlock()
+				if done {
+					// A transaction with a lower index has already failed.
+					return
+				}
+
+				if err := trxs[i].checkSignature(); err != nil {
+					mtx.Lock()
+					if i < failedIndex {
+						failedIndex = i
+						failedErr = err
+					}
+					mtx.Unlock()
+				}
+			}
+		}()
+	}
+	wg.Wait()
+
+	if failedErr != nil {
+		return failedIndex, failedErr
+	}
+	return -1, nil
+}
@@ -0,0 +1,125 @@
+package tx_test
+
+import (
+	"testing"
+
+	"github.com/pactus-project/pactus/types/tx"
+	"github.com/pactus-project/pactus/util/errors"
+	"github.com/pactus-project/pactus/util/testsuite"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func generateTestTxs(ts *testsuite.TestSuite, count int) []*tx.Tx {
+	trxs := make([]*tx.Tx, 0, count)
+	trxs = append(trxs, tx.NewSubsidyTx(ts.RandomStamp(), 1, ts.RandomAddress(), 1e9, "subsidy"))
+	for len(trxs) < count {
+		trx, _ := ts.GenerateTestTransferTx()
+		trxs = append(trxs, trx)
+	}
+	return trxs
+}
+
+func TestVerifySignatures(t *testing.T) {
+	ts := testsuite.NewTestSuite(t)
+
+	t.Run("Empty batch", func(t *testing.T) {
+		i, err := tx.VerifySignatures([]*tx.Tx{})
+		assert.NoError(t, err)
+		assert.Equal(t, i, -1)
+	})
+
+	t.Run("All signatures are valid", func(t *testing.T) {
+		trxs := generateTestTxs(ts, 32)
+
+		i, err := tx.VerifySignatures(trxs)
+		assert.NoError(t, err)
+		assert.Equal(t, i, -1)
+	})
+
+	t.Run("Should report the index of the invalid signature", func(t *testing.T) {
+		trxs := generateTestTxs(ts, 32)
+		trxs[17].SetSignature(trxs[18].Signature())
+
+		i, err := tx.VerifySignatures(trxs)
+		assert.Equal(t, errors.Code(err), errors.ErrInvalidSignature)
+		assert.Equal(t, i, 17)
+
+		assert.Equal(t, errors.Code(trxs[17].SanityCheck()), errors.ErrInvalidSignature)
+	})
+
+	t.Run("Should report the first invalid index", func(t *testing.T) {
+		trxs := generateTestTxs(ts, 32)
+		trxs[9].SetPublicKey(ts.RandomSigner().PublicKey())
+		trxs[25].SetSignature(trxs[24].Signature())
+
+		i, err := tx.VerifySignatures(trxs)
+		assert.Equal(t, errors.Code(err), errors.ErrInvalidAddress)
+		assert.Equal(t, i, 9)
+	})
+
+	t.Run("Same transaction more than once", func(t *testing.T) {
+		trxs := generateTestTxs(ts, 4)
+		trxs = append(trxs, trxs...)
+
+		i, err := tx.VerifySignatures(trxs)
+		assert.NoError(t, err)
+		assert.Equal(t, i, -1)
+
+		trxs[1].SetSignature(trxs[2].Signature())
+		i, err = tx.VerifySignatures(trxs)
+		assert.Equal(t, errors.Code(err), errors.ErrInvalidSignature)
+		assert.Equal(t, i, 1)
+	})
+}
+
+// decodeTestTxs decodes the transactions again, so they are not sanity checked yet.
+func decodeTestTxs(b *testing.B, data [][]byte) []*tx.Tx {
+	b.Helper()
+
+	trxs := make([]*tx.Tx, len(data))
+	for i, d := range data {
+		trx, err := tx.FromBytes(d)
+		require.NoError(b, err)
+		trxs[i] = trx
+	}
+	return trxs
+}
+
+func BenchmarkVerifySignatures(b *testing.B) {
+	ts := testsuite.NewTestSuiteForSeed(1)
+
+	trxs := generateTestTxs(ts, 256)
+	data := make([][]byte, len(trxs))
+	for i, trx := range trxs {
+		d, err := trx.Bytes()
+		require.NoError(b, err)
+		data[i] = d
+	}
+
+	b.Run("Serial", func(b *testing.B) {
+		for n := 0; n < b.N; n++ {
+			b.StopTimer()
+			trxs := decodeTestTxs(b, data)
+			b.StartTimer()
+
+			for _, trx := range trxs {
+				if err := trx.SanityCheck(); err != nil {
+					b.Fatal(err)
+				}
+			}
+		}
+	})
+
+	b.Run("Parallel", func(b *testing.B) {
+		for n := 0; n < b.N; n++ {
+			b.StopTimer()
+			trxs := decodeTestTxs(b, data)
+			b.StartTimer()
+
+			if _, err := tx.VerifySignatures(trxs); err != nil {
+				b.Fatal(err)
+			}
+		}
+	})
+}
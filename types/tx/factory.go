@@ -53,6 +53,15 @@ func NewUnbondTx(stamp hash.Stamp, seq int32,
 	return NewTx(stamp, seq, pld, 0, memo)
 }
 
+func NewUnjailTx(stamp hash.Stamp, seq int32,
+	val crypto.Address,
+	memo string) *Tx {
+	pld := &payload.UnjailPayload{
+		Validator: val,
+	}
+	return NewTx(stamp, seq, pld, 0, memo)
+}
+
 func NewWithdrawTx(stamp hash.Stamp, seq int32,
 	val crypto.Address,
 	acc crypto.Address,
@@ -15,6 +15,7 @@ const (
 	PayloadTypeSortition = Type(3)
 	PayloadTypeUnbond    = Type(4)
 	PayloadTypeWithdraw  = Type(5)
+	PayloadTypeUnjail    = Type(6)
 )
 
 func (t Type) String() string {
@@ -29,6 +30,8 @@ func (t Type) String() string {
 		return "withdraw"
 	case PayloadTypeSortition:
 		return "sortition"
+	case PayloadTypeUnjail:
+		return "unjail"
 	}
 	return fmt.Sprintf("%d", t)
 }
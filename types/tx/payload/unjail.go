@@ -0,0 +1,52 @@
+package payload
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/pactus-project/pactus/crypto"
+	"github.com/pactus-project/pactus/util/encoding"
+	"github.com/pactus-project/pactus/util/errors"
+)
+
+type UnjailPayload struct {
+	Validator crypto.Address
+}
+
+func (p *UnjailPayload) Type() Type {
+	return PayloadTypeUnjail
+}
+
+func (p *UnjailPayload) Signer() crypto.Address {
+	return p.Validator
+}
+
+func (p *UnjailPayload) Value() int64 {
+	return 0
+}
+
+func (p *UnjailPayload) SanityCheck() error {
+	if err := p.Validator.SanityCheck(); err != nil {
+		return errors.Error(errors.ErrInvalidAddress)
+	}
+
+	return nil
+}
+
+func (p *UnjailPayload) SerializeSize() int {
+	return 21
+}
+
+func (p *UnjailPayload) Encode(w io.Writer) error {
+	return encoding.WriteElements(w, &p.Validator)
+}
+
+func (p *UnjailPayload) Decode(r io.Reader) error {
+	return encoding.ReadElements(r, &p.Validator)
+}
+
+func (p *UnjailPayload) Fingerprint() string {
+	return fmt.Sprintf("{Unjail 🔑 %v}",
+		p.Validator.Fingerprint(),
+	)
+}
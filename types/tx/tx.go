@@ -19,6 +19,12 @@ const flagLockTime = 0x80
 
 const maxMemoLength = 64
 
+// MaxTxBytes is the maximum size of an encoded transaction in bytes.
+// The largest valid transaction (a bond transaction with a public key and
+// the longest memo) is smaller than this. Raw transactions that exceed this
+// size are rejected before decoding.
+const MaxTxBytes = 512
+
 type ID = hash.Hash
 
 type Tx struct {
@@ -82,6 +88,9 @@ func NewLockTimeTx(lockTime uint32, seq int32, pld payload.Payload, fee int64,
 
 // FromBytes constructs a new transaction from byte array.
 func FromBytes(bs []byte) (*Tx, error) {
+	if err := checkTxBytes(bs); err != nil {
+		return nil, err
+	}
 	tx := new(Tx)
 	r := bytes.NewReader(bs)
 	if err := tx.Decode(r); err != nil {
@@ -144,10 +153,34 @@ func (tx *Tx) SetPublicKey(pub crypto.PublicKey) {
 	tx.data.PublicKey = pub
 }
 
+// checkTxBytes rejects oversized raw transactions without decoding them.
+func checkTxBytes(bs []byte) error {
+	if len(bs) > MaxTxBytes {
+		return errors.Errorf(errors.ErrInvalidTx,
+			"transaction size exceeded, maximum: %v, got: %v", MaxTxBytes, len(bs))
+	}
+	return nil
+}
+
 func (tx *Tx) SanityCheck() error {
 	if tx.sanityChecked {
 		return nil
 	}
+	if err := tx.BasicCheck(); err != nil {
+		return err
+	}
+	if err := tx.checkSignature(); err != nil {
+		return err
+	}
+
+	tx.sanityChecked = true
+
+	return nil
+}
+
+// BasicCheck checks the transaction without verifying its signature.
+// Unlike SanityCheck, it doesn't mark the transaction as checked.
+func (tx *Tx) BasicCheck() error {
 	if tx.Version() != versionLatest {
 		return errors.Errorf(errors.ErrInvalidTx, "invalid version")
 	}
@@ -166,23 +199,22 @@ func (tx *Tx) SanityCheck() error {
 	if len(tx.Memo()) > maxMemoLength {
 		return errors.Error(errors.ErrInvalidMemo)
 	}
-	if err := tx.checkSignature(); err != nil {
-		return err
-	}
-
-	tx.sanityChecked = true
 
 	return nil
 }
 
 func (tx *Tx) checkFee() error {
-	if tx.IsFreeTx() {
+	if tx.IsSystemTx() {
+		if tx.Fee() != 0 {
+			return errors.Errorf(errors.ErrInvalidFee, "system transaction should have no fee")
+		}
+	} else if tx.IsFreeTx() {
 		if tx.Fee() != 0 {
 			return errors.Errorf(errors.ErrInvalidFee, "fee should set to zero")
 		}
 	} else {
 		if tx.Fee() <= 0 {
-			return errors.Errorf(errors.ErrInvalidFee, "fee should not be negative")
+			return errors.Errorf(errors.ErrInvalidFee, "fee should be greater than zero")
 		}
 	}
 
@@ -240,6 +272,9 @@ func (tx *Tx) UnmarshalCBOR(bs []byte) error {
 	if err != nil {
 		return err
 	}
+	if err := checkTxBytes(data); err != nil {
+		return err
+	}
 	buf := bytes.NewBuffer(data)
 	return tx.Decode(buf)
 }
@@ -341,6 +376,8 @@ func (tx *Tx) DecodeWithNoSignatory(r io.Reader) error {
 		tx.data.Payload = &payload.WithdrawPayload{}
 	case payload.PayloadTypeSortition:
 		tx.data.Payload = &payload.SortitionPayload{}
+	case payload.PayloadTypeUnjail:
+		tx.data.Payload = &payload.UnjailPayload{}
 
 	default:
 		return errors.Errorf(errors.ErrInvalidTx, "invalid payload")
@@ -432,7 +469,20 @@ func (tx *Tx) IsWithdrawTx() bool {
 	return tx.Payload().Type() == payload.PayloadTypeWithdraw
 }
 
+func (tx *Tx) IsUnjailTx() bool {
+	return tx.Payload().Type() == payload.PayloadTypeUnjail
+}
+
+// IsSystemTx checks if the transaction is issued by the protocol itself.
+// System transactions are issued on behalf of the treasury, like the subsidy
+// transaction that distributes the block reward. They carry no fee.
+func (tx *Tx) IsSystemTx() bool {
+	return tx.data.Payload.Signer().EqualsTo(crypto.TreasuryAddress)
+}
+
 // IsFreeTx will checks if transaction fee is 0.
+// Besides the system transactions, validator maintenance transactions
+// (sortition, unbond and unjail) are free.
 func (tx *Tx) IsFreeTx() bool {
-	return tx.IsSubsidyTx() || tx.IsSortitionTx() || tx.IsUnbondTx()
+	return tx.IsSystemTx() || tx.IsSortitionTx() || tx.IsUnbondTx() || tx.IsUnjailTx()
 }
@@ -64,12 +64,15 @@ func TestFromBytes(t *testing.T) {
 	trx3, _ := ts.GenerateTestUnbondTx()
 	trx4, _ := ts.GenerateTestWithdrawTx()
 	trx5, _ := ts.GenerateTestSortitionTx()
-	tests := []*tx.Tx{trx1, trx2, trx3, trx4, trx5}
+	trx6, _ := ts.GenerateTestUnjailTx()
+	tests := []*tx.Tx{trx1, trx2, trx3, trx4, trx5, trx6}
 	assert.True(t, trx1.IsTransferTx())
 	assert.True(t, trx2.IsBondTx())
 	assert.True(t, trx3.IsUnbondTx())
 	assert.True(t, trx4.IsWithdrawTx())
 	assert.True(t, trx5.IsSortitionTx())
+	assert.True(t, trx6.IsUnjailTx())
+	assert.True(t, trx6.IsFreeTx())
 
 	for _, trx := range tests {
 		assert.NoError(t, trx.SanityCheck())
@@ -96,6 +99,57 @@ func TestFromBytes(t *testing.T) {
 	assert.Error(t, err)
 }
 
+func TestMaxTxBytes(t *testing.T) {
+	ts := testsuite.NewTestSuite(t)
+
+	signer := ts.RandomSigner()
+	pub, _ := ts.RandomBLSKeyPair()
+	trx := tx.NewBondTx(ts.RandomStamp(), ts.RandInt32(1000), signer.Address(), pub.Address(),
+		pub, 21*1e14, 1e9, strings.Repeat("m", 64))
+	signer.SignMsg(trx)
+	bs, err := trx.Bytes()
+	require.NoError(t, err)
+	assert.Less(t, len(bs), tx.MaxTxBytes, "the largest valid transaction should fit")
+
+	// makeTx returns a well-formed transaction that is encoded in exactly `size` bytes.
+	// The memo is filled to reach the size, so it is longer than a valid memo.
+	makeTx := func(size int) []byte {
+		for memoLen := 0; memoLen < size; memoLen++ {
+			trx := tx.NewBondTx(ts.RandomStamp(), ts.RandInt32(1000), signer.Address(), pub.Address(),
+				pub, 21*1e14, 1e9, strings.Repeat("m", memoLen))
+			signer.SignMsg(trx)
+			bs, err := trx.Bytes()
+			require.NoError(t, err)
+			if len(bs) == size {
+				return bs
+			}
+		}
+		require.FailNow(t, "unable to make a transaction", "size: %v", size)
+		return nil
+	}
+
+	t.Run("Transaction at the limit should be decoded", func(t *testing.T) {
+		bs := makeTx(tx.MaxTxBytes)
+
+		decoded, err := tx.FromBytes(bs)
+		assert.NoError(t, err)
+		decodedBytes, _ := decoded.Bytes()
+		assert.Equal(t, bs, decodedBytes)
+	})
+
+	t.Run("Transaction over the limit should be rejected before decoding", func(t *testing.T) {
+		// It would be decoded successfully if the size was not checked first.
+		bs := makeTx(tx.MaxTxBytes + 1)
+
+		_, err := tx.FromBytes(bs)
+		assert.Equal(t, errors.Code(err), errors.ErrInvalidTx)
+
+		bz, _ := cbor.Marshal(bs)
+		err = cbor.Unmarshal(bz, new(tx.Tx))
+		assert.Equal(t, errors.Code(err), errors.ErrInvalidTx)
+	})
+}
+
 func TestTxIDNoSignatory(t *testing.T) {
 	ts := testsuite.NewTestSuite(t)
 
@@ -213,6 +267,55 @@ func TestInvalidFee(t *testing.T) {
 		err := trx.SanityCheck()
 		assert.Equal(t, errors.Code(err), errors.ErrInvalidFee)
 	})
+
+	t.Run("Zero fee for a normal transaction", func(t *testing.T) {
+		trx := tx.NewTransferTx(ts.RandomStamp(), ts.RandInt32NonZero(100),
+			ts.RandomAddress(), ts.RandomAddress(), 1, 0, "zero fee")
+		assert.False(t, trx.IsFreeTx())
+
+		err := trx.SanityCheck()
+		assert.Equal(t, errors.Code(err), errors.ErrInvalidFee)
+	})
+}
+
+func TestSystemTx(t *testing.T) {
+	ts := testsuite.NewTestSuite(t)
+
+	trx1 := tx.NewSubsidyTx(ts.RandomStamp(), ts.RandInt32NonZero(100),
+		ts.RandomAddress(), 1e9, "subsidy")
+	trx2, _ := ts.GenerateTestTransferTx()
+	trx3, _ := ts.GenerateTestBondTx()
+	trx4, _ := ts.GenerateTestUnbondTx()
+	trx5, _ := ts.GenerateTestWithdrawTx()
+	trx6, _ := ts.GenerateTestSortitionTx()
+	trx7, _ := ts.GenerateTestUnjailTx()
+
+	assert.True(t, trx1.IsSystemTx())
+	assert.False(t, trx2.IsSystemTx())
+	assert.False(t, trx3.IsSystemTx())
+	assert.False(t, trx4.IsSystemTx())
+	assert.False(t, trx5.IsSystemTx())
+	assert.False(t, trx6.IsSystemTx())
+	assert.False(t, trx7.IsSystemTx())
+
+	assert.True(t, trx1.IsFreeTx())
+	assert.False(t, trx2.IsFreeTx())
+	assert.False(t, trx3.IsFreeTx())
+	assert.True(t, trx4.IsFreeTx())
+	assert.False(t, trx5.IsFreeTx())
+	assert.True(t, trx6.IsFreeTx())
+	assert.True(t, trx7.IsFreeTx())
+
+	assert.Zero(t, trx1.Fee())
+	assert.NoError(t, trx1.SanityCheck())
+
+	t.Run("System transaction with fee", func(t *testing.T) {
+		trx := tx.NewTransferTx(ts.RandomStamp(), ts.RandInt32NonZero(100),
+			crypto.TreasuryAddress, ts.RandomAddress(), 1e9, 1000, "system tx")
+
+		err := trx.SanityCheck()
+		assert.Equal(t, errors.Code(err), errors.ErrInvalidFee)
+	})
 }
 
 func TestSubsidyTx(t *testing.T) {
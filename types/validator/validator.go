@@ -8,6 +8,17 @@ import (
 	"github.com/pactus-project/pactus/crypto/bls"
 	"github.com/pactus-project/pactus/crypto/hash"
 	"github.com/pactus-project/pactus/util/encoding"
+	"github.com/pactus-project/pactus/util/errors"
+)
+
+// The extended fields (absent count, jailed height and activating stake) are
+// encoded after the legacy fields, prefixed by a version byte.
+// A validator without any extended data keeps the legacy encoding,
+// so the validators that are already stored, and their hashes, don't change.
+const (
+	legacySize       = 124 // 96+4+4+8+4+4+4
+	extendedVersion1 = 0x01
+	extendedSize     = 17 // 1+4+4+8
 )
 
 // The Validator struct represents a validator object.
@@ -24,6 +35,9 @@ type validatorData struct {
 	LastBondingHeight uint32
 	UnbondingHeight   uint32
 	LastJoinedHeight  uint32
+	AbsentCount       uint32
+	JailedHeight      uint32
+	ActivatingStake   int64
 }
 
 // NewValidator constructs a new validator from the given public key and number.
@@ -60,6 +74,29 @@ func FromBytes(data []byte) (*Validator, error) {
 		return nil, err
 	}
 
+	if r.Len() == 0 {
+		// Legacy encoding
+		return acc, nil
+	}
+
+	var version uint8
+	if err := encoding.ReadElement(r, &version); err != nil {
+		return nil, err
+	}
+	if version != extendedVersion1 {
+		return nil, errors.Errorf(errors.ErrInvalidMessage, "invalid validator version: %v", version)
+	}
+
+	err = encoding.ReadElements(r,
+		&acc.data.AbsentCount,
+		&acc.data.JailedHeight,
+		&acc.data.ActivatingStake,
+	)
+
+	if err != nil {
+		return nil, err
+	}
+
 	return acc, nil
 }
 
@@ -103,6 +140,22 @@ func (val *Validator) LastJoinedHeight() uint32 {
 	return val.data.LastJoinedHeight
 }
 
+// AbsentCount returns the number of consecutive certificates in which the validator was absent.
+func (val *Validator) AbsentCount() uint32 {
+	return val.data.AbsentCount
+}
+
+// JailedHeight returns the height at which the validator was jailed.
+// It returns zero if the validator is not jailed.
+func (val *Validator) JailedHeight() uint32 {
+	return val.data.JailedHeight
+}
+
+// IsJailed checks if the validator is jailed.
+func (val *Validator) IsJailed() bool {
+	return val.data.JailedHeight > 0
+}
+
 // Power returns the power of the validator.
 func (val Validator) Power() int64 {
 	if val.data.UnbondingHeight > 0 {
@@ -115,6 +168,35 @@ func (val Validator) Power() int64 {
 	return val.data.Stake
 }
 
+// ActivatingStake returns the amount of stake that is activating since the last bonding height.
+func (val *Validator) ActivatingStake() int64 {
+	return val.data.ActivatingStake
+}
+
+// InactiveStake returns the part of the activating stake that is not effective yet at the given height.
+// The activating stake becomes effective linearly over the activation interval.
+func (val *Validator) InactiveStake(height, interval uint32) int64 {
+	if interval == 0 || val.data.UnbondingHeight > 0 {
+		return 0
+	}
+
+	elapsed := uint32(0)
+	if height > val.data.LastBondingHeight {
+		elapsed = height - val.data.LastBondingHeight
+	}
+	if elapsed >= interval {
+		return 0
+	}
+
+	return val.data.ActivatingStake * int64(interval-elapsed) / int64(interval)
+}
+
+// EffectivePower returns the power of the validator at the given height,
+// excluding the stake that is not activated yet.
+func (val *Validator) EffectivePower(height, interval uint32) int64 {
+	return val.Power() - val.InactiveStake(height, interval)
+}
+
 // SubtractFromStake subtracts the given amount from the validator's stake.
 func (val *Validator) SubtractFromStake(amt int64) {
 	val.data.Stake -= amt
@@ -140,11 +222,32 @@ func (val *Validator) UpdateLastBondingHeight(height uint32) {
 	val.data.LastBondingHeight = height
 }
 
+// UpdateActivatingStake updates the amount of stake that is activating since the last bonding height.
+func (val *Validator) UpdateActivatingStake(amt int64) {
+	val.data.ActivatingStake = amt
+}
+
 // UpdateUnbondingHeight updates the unbonding height for the validator.
 func (val *Validator) UpdateUnbondingHeight(height uint32) {
 	val.data.UnbondingHeight = height
 }
 
+// IncAbsentCount increases the absent counter anytime the validator misses signing a certificate.
+func (val *Validator) IncAbsentCount() {
+	val.data.AbsentCount++
+}
+
+// ResetAbsentCount resets the absent counter for the validator.
+func (val *Validator) ResetAbsentCount() {
+	val.data.AbsentCount = 0
+}
+
+// UpdateJailedHeight updates the jailed height for the validator.
+// Setting the height to zero releases the validator from jail.
+func (val *Validator) UpdateJailedHeight(height uint32) {
+	val.data.JailedHeight = height
+}
+
 // Hash calculates and returns the hash of the validator.
 func (val *Validator) Hash() hash.Hash {
 	bs, err := val.Bytes()
@@ -156,7 +259,18 @@ func (val *Validator) Hash() hash.Hash {
 
 // SerializeSize returns the size in bytes required to serialize the validator.
 func (val *Validator) SerializeSize() int {
-	return 124 // 96+4+4+8+4+4+4
+	if val.isLegacy() {
+		return legacySize
+	}
+	return legacySize + extendedSize
+}
+
+// isLegacy checks if the validator has no extended data,
+// so it can be encoded in the legacy format.
+func (val *Validator) isLegacy() bool {
+	return val.data.AbsentCount == 0 &&
+		val.data.JailedHeight == 0 &&
+		val.data.ActivatingStake == 0
 }
 
 // Bytes returns returns the serialized byte representation of the validator.
@@ -178,6 +292,19 @@ func (val *Validator) Bytes() ([]byte, error) {
 		return nil, err
 	}
 
+	if val.isLegacy() {
+		return w.Bytes(), nil
+	}
+
+	err = encoding.WriteElements(w,
+		uint8(extendedVersion1),
+		val.data.AbsentCount,
+		val.data.JailedHeight,
+		val.data.ActivatingStake)
+	if err != nil {
+		return nil, err
+	}
+
 	return w.Bytes(), nil
 }
 
@@ -21,6 +21,9 @@ func TestFromBytes(t *testing.T) {
 	val.UpdateLastBondingHeight(ts.RandUint32(1000000))
 	val.UpdateLastJoinedHeight(ts.RandUint32(1000000))
 	val.UpdateUnbondingHeight(ts.RandUint32(1000000))
+	val.UpdateJailedHeight(ts.RandUint32(1000000))
+	val.IncAbsentCount()
+	val.UpdateActivatingStake(ts.RandInt64(1000000))
 	bs, err := val.Bytes()
 	require.NoError(t, err)
 	require.Equal(t, val.SerializeSize(), len(bs))
@@ -33,6 +36,9 @@ func TestFromBytes(t *testing.T) {
 	assert.Equal(t, val.LastBondingHeight(), val2.LastBondingHeight())
 	assert.Equal(t, val.LastJoinedHeight(), val2.LastJoinedHeight())
 	assert.Equal(t, val.UnbondingHeight(), val2.UnbondingHeight())
+	assert.Equal(t, val.AbsentCount(), val2.AbsentCount())
+	assert.Equal(t, val.JailedHeight(), val2.JailedHeight())
+	assert.Equal(t, val.ActivatingStake(), val2.ActivatingStake())
 
 	_, err = validator.FromBytes([]byte("asdfghjkl"))
 	require.Error(t, err)
@@ -40,6 +46,15 @@ func TestFromBytes(t *testing.T) {
 	bs = bs[:len(bs)-1]
 	_, err = validator.FromBytes(bs)
 	require.Error(t, err)
+
+	// Validators without extended data keep the legacy encoding
+	val3, _ := ts.GenerateTestValidator(ts.RandInt32(1000000))
+	bs, err = val3.Bytes()
+	require.NoError(t, err)
+	require.Equal(t, val3.SerializeSize(), len(bs))
+	require.Equal(t, 124, len(bs))
+	_, err = validator.FromBytes(bs)
+	require.NoError(t, err)
 }
 
 func TestDecoding(t *testing.T) {
@@ -58,6 +73,40 @@ func TestDecoding(t *testing.T) {
 	assert.True(t, val.PublicKey().EqualsTo(pub))
 }
 
+func TestDecodingExtended(t *testing.T) {
+	bs, err := hex.DecodeString(
+		"95167c2a0d86ec360407bce89b304616e1d0f83dbc200642abea8405e1838312fb8290b1230ebe4369cf1b7f556906c610ae92bcee544a1" +
+			"af79e259996e368b14851a1f8844274690b10df983bc2776ab10cc37e49e175bc7ae17ac919b8c34c01000000020000000300000000" +
+			"000000040000000500000006000000" +
+			"0107000000080000000900000000000000")
+	require.NoError(t, err)
+	val, err := validator.FromBytes(bs)
+	require.NoError(t, err)
+	bs2, _ := val.Bytes()
+	assert.Equal(t, bs, bs2)
+	assert.Equal(t, val.SerializeSize(), len(bs))
+	assert.Equal(t, val.AbsentCount(), uint32(7))
+	assert.Equal(t, val.JailedHeight(), uint32(8))
+	assert.Equal(t, val.ActivatingStake(), int64(9))
+	assert.Equal(t, val.Hash(), hash.CalcHash(bs))
+
+	t.Run("Invalid version", func(t *testing.T) {
+		bs[124] = 0x02
+		_, err := validator.FromBytes(bs)
+		assert.Error(t, err)
+	})
+
+	t.Run("Resetting the extended data restores the legacy encoding", func(t *testing.T) {
+		val.ResetAbsentCount()
+		val.UpdateJailedHeight(0)
+		val.UpdateActivatingStake(0)
+
+		bs3, _ := val.Bytes()
+		assert.Equal(t, bs3, bs[:124])
+		assert.Equal(t, val.SerializeSize(), 124)
+	})
+}
+
 func TestIncSequence(t *testing.T) {
 	ts := testsuite.NewTestSuite(t)
 
@@ -81,6 +130,51 @@ func TestPower(t *testing.T) {
 	assert.Equal(t, val.Stake(), int64(1))
 	assert.Equal(t, val.Power(), int64(0))
 }
+
+func TestEffectivePower(t *testing.T) {
+	ts := testsuite.NewTestSuite(t)
+
+	pub, _ := ts.RandomBLSKeyPair()
+	val := validator.NewValidator(pub, 0)
+	val.AddToStake(1000)
+	val.UpdateActivatingStake(1000)
+	val.UpdateLastBondingHeight(100)
+
+	t.Run("Activation is disabled", func(t *testing.T) {
+		assert.Zero(t, val.InactiveStake(100, 0))
+		assert.Equal(t, val.EffectivePower(100, 0), int64(1000))
+	})
+
+	t.Run("Effective power ramps up over the activation interval", func(t *testing.T) {
+		tests := []struct {
+			height         uint32
+			effectivePower int64
+		}{
+			{99, 0},
+			{100, 0},
+			{101, 100},
+			{105, 500},
+			{109, 900},
+			{110, 1000},
+			{200, 1000},
+		}
+
+		for _, test := range tests {
+			assert.Equal(t, val.EffectivePower(test.height, 10), test.effectivePower,
+				"height %v", test.height)
+			assert.Equal(t, val.Stake(), int64(1000))
+		}
+	})
+
+	t.Run("Unbonded validator has no power", func(t *testing.T) {
+		unbonded := val.Clone()
+		unbonded.UpdateUnbondingHeight(105)
+
+		assert.Zero(t, unbonded.InactiveStake(105, 10))
+		assert.Zero(t, unbonded.EffectivePower(105, 10))
+	})
+}
+
 func TestAddToStake(t *testing.T) {
 	ts := testsuite.NewTestSuite(t)
 
@@ -108,3 +202,26 @@ func TestClone(t *testing.T) {
 
 	assert.NotEqual(t, val.Sequence(), cloned.Sequence())
 }
+
+func TestJail(t *testing.T) {
+	ts := testsuite.NewTestSuite(t)
+
+	val, _ := ts.GenerateTestValidator(100)
+	assert.False(t, val.IsJailed())
+	assert.Zero(t, val.AbsentCount())
+
+	val.IncAbsentCount()
+	val.IncAbsentCount()
+	assert.Equal(t, val.AbsentCount(), uint32(2))
+	val.ResetAbsentCount()
+	assert.Zero(t, val.AbsentCount())
+
+	stake := val.Stake()
+	val.UpdateJailedHeight(100)
+	assert.True(t, val.IsJailed())
+	assert.Equal(t, val.JailedHeight(), uint32(100))
+	assert.Equal(t, val.Stake(), stake)
+
+	val.UpdateJailedHeight(0)
+	assert.False(t, val.IsJailed())
+}
@@ -27,6 +27,7 @@ const (
 	ErrInvalidConfig
 	ErrDuplicateVote
 	ErrInsufficientFunds
+	ErrTooManyValidators
 
 	ErrCount
 )
@@ -54,6 +55,7 @@ var messages = map[int]string{
 	ErrInvalidConfig:     "invalid config",
 	ErrDuplicateVote:     "duplicate vote",
 	ErrInsufficientFunds: "insufficient funds",
+	ErrTooManyValidators: "too many validators",
 }
 
 type withCode struct {
@@ -67,6 +67,18 @@ func (l *DoublyLinkedList[T]) InsertAtTail(data T) *LinkNode[T] {
 	return newNode
 }
 
+// InsertUnique appends a new node at the tail of the list if no equal node exists.
+// It returns the existing or the new node, and a boolean indicating whether the node was inserted.
+func (l *DoublyLinkedList[T]) InsertUnique(data T, equal func(a, b T) bool) (*LinkNode[T], bool) {
+	for cur := l.Head; cur != nil; cur = cur.Next {
+		if equal(cur.Data, data) {
+			return cur, false
+		}
+	}
+
+	return l.InsertAtTail(data), true
+}
+
 // DeleteAtHead deletes the node at the head of the list
 func (l *DoublyLinkedList[T]) DeleteAtHead() {
 	if l.Head == nil {
@@ -134,6 +146,33 @@ func (l *DoublyLinkedList[T]) Values() []T {
 	return values
 }
 
+// Equal compares two lists element-wise using the given equality function
+func (l *DoublyLinkedList[T]) Equal(other *DoublyLinkedList[T], eq func(a, b T) bool) bool {
+	if l.length != other.length {
+		return false
+	}
+
+	cur1, cur2 := l.Head, other.Head
+	for cur1 != nil && cur2 != nil {
+		if !eq(cur1.Data, cur2.Data) {
+			return false
+		}
+		cur1 = cur1.Next
+		cur2 = cur2.Next
+	}
+	return cur1 == nil && cur2 == nil
+}
+
+// Fold reduces the values of the list, from head to tail, into a single value.
+// It is a function, not a method, because methods can't have type parameters.
+func Fold[T, U any](l *DoublyLinkedList[T], init U, f func(U, T) U) U {
+	acc := init
+	for cur := l.Head; cur != nil; cur = cur.Next {
+		acc = f(acc, cur.Data)
+	}
+	return acc
+}
+
 // Clear removes all nodes from the list, making it empty
 func (l *DoublyLinkedList[T]) Clear() {
 	l.Head = nil
@@ -1,6 +1,7 @@
 package linkedmap
 
 import (
+	"fmt"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -32,6 +33,36 @@ func TestSinglyLink_InsertAtTail(t *testing.T) {
 	assert.Equal(t, link.Tail.Data, 4)
 }
 
+func TestInsertUnique(t *testing.T) {
+	equal := func(a, b int) bool { return a == b }
+	link := NewDoublyLinkedList[int]()
+
+	t.Run("Insert into empty list", func(t *testing.T) {
+		n1, inserted := link.InsertUnique(1, equal)
+		assert.True(t, inserted)
+		assert.Equal(t, n1.Data, 1)
+		assert.Equal(t, link.Values(), []int{1})
+		assert.Equal(t, link.Length(), 1)
+	})
+
+	t.Run("Insert new value", func(t *testing.T) {
+		n2, inserted := link.InsertUnique(2, equal)
+		assert.True(t, inserted)
+		assert.Equal(t, n2.Data, 2)
+		assert.Equal(t, link.Tail, n2)
+		assert.Equal(t, link.Values(), []int{1, 2})
+		assert.Equal(t, link.Length(), 2)
+	})
+
+	t.Run("Insert duplicated value", func(t *testing.T) {
+		n1, inserted := link.InsertUnique(1, equal)
+		assert.False(t, inserted)
+		assert.Equal(t, link.Head, n1)
+		assert.Equal(t, link.Values(), []int{1, 2})
+		assert.Equal(t, link.Length(), 2)
+	})
+}
+
 func TestDeleteAtHead(t *testing.T) {
 	link := NewDoublyLinkedList[int]()
 	link.InsertAtTail(1)
@@ -112,3 +143,54 @@ func TestClear(t *testing.T) {
 	assert.Equal(t, link.Values(), []int{})
 	assert.Equal(t, link.Length(), 0)
 }
+
+func TestEqual(t *testing.T) {
+	eq := func(a, b int) bool { return a == b }
+	newList := func(values ...int) *DoublyLinkedList[int] {
+		link := NewDoublyLinkedList[int]()
+		for _, v := range values {
+			link.InsertAtTail(v)
+		}
+		return link
+	}
+
+	tests := []struct {
+		name  string
+		a     *DoublyLinkedList[int]
+		b     *DoublyLinkedList[int]
+		equal bool
+	}{
+		{"empty lists", newList(), newList(), true},
+		{"same values", newList(1, 2, 3), newList(1, 2, 3), true},
+		{"different order", newList(1, 2, 3), newList(3, 2, 1), false},
+		{"different length", newList(1, 2, 3), newList(1, 2), false},
+		{"empty and non-empty", newList(), newList(1), false},
+		{"different values", newList(1, 2, 3), newList(1, 2, 4), false},
+	}
+
+	for _, test := range tests {
+		assert.Equal(t, test.equal, test.a.Equal(test.b, eq), test.name)
+		assert.Equal(t, test.equal, test.b.Equal(test.a, eq), test.name)
+	}
+}
+
+func TestFold(t *testing.T) {
+	link := NewDoublyLinkedList[int]()
+	sum := func(acc, v int) int { return acc + v }
+
+	assert.Equal(t, Fold(link, 0, sum), 0)
+
+	link.InsertAtTail(1)
+	link.InsertAtTail(2)
+	link.InsertAtTail(3)
+	link.InsertAtTail(4)
+
+	assert.Equal(t, Fold(link, 0, sum), 10)
+	assert.Equal(t, Fold(link, 5, sum), 15)
+
+	// Fold can return a different type and visits the values from head to tail
+	str := Fold(link, "", func(acc string, v int) string {
+		return acc + fmt.Sprint(v)
+	})
+	assert.Equal(t, str, "1234")
+}
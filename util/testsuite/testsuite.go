@@ -351,6 +351,15 @@ func (ts *TestSuite) GenerateTestUnbondTx() (*tx.Tx, crypto.Signer) {
 	return tx, s
 }
 
+// GenerateTestUnjailTx generates an unjail transaction for testing.
+func (ts *TestSuite) GenerateTestUnjailTx() (*tx.Tx, crypto.Signer) {
+	stamp := ts.RandomStamp()
+	s := ts.RandomSigner()
+	tx := tx.NewUnjailTx(stamp, ts.RandInt32(1000), s.Address(), "test unjail-tx")
+	s.SignMsg(tx)
+	return tx, s
+}
+
 // GenerateTestWithdrawTx generates a withdraw transaction for testing.
 func (ts *TestSuite) GenerateTestWithdrawTx() (*tx.Tx, crypto.Signer) {
 	stamp := ts.RandomStamp()
@@ -3,6 +3,7 @@ package grpc
 import (
 	"testing"
 
+	"github.com/pactus-project/pactus/types/tx"
 	"github.com/pactus-project/pactus/types/tx/payload"
 	"github.com/pactus-project/pactus/util/testsuite"
 	pactus "github.com/pactus-project/pactus/www/grpc/gen/go"
@@ -65,6 +66,13 @@ func TestSendRawTransaction(t *testing.T) {
 		assert.Error(t, err)
 		assert.Nil(t, res)
 	})
+	t.Run("Should fail, oversized transaction", func(t *testing.T) {
+		res, err := client.SendRawTransaction(tCtx, &pactus.SendRawTransactionRequest{
+			Data: ts.RandomBytes(tx.MaxTxBytes + 1),
+		})
+		assert.Error(t, err)
+		assert.Nil(t, res)
+	})
 	t.Run("Should fail, transaction with invalid signature", func(t *testing.T) {
 		trx, _ := ts.GenerateTestTransferTx()
 		_, signer := ts.GenerateTestTransferTx()